@@ -6,8 +6,8 @@ package names
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
-	"github.com/juju/errors"
 	"github.com/juju/utils/v3"
 )
 
@@ -19,6 +19,15 @@ const ActionSnippet = NumberSnippet
 
 var validActionV2 = regexp.MustCompile("^" + ActionSnippet + "$")
 
+const (
+	// ActionVersionUnknown is the version of an action tag without an id.
+	ActionVersionUnknown = 0
+	// ActionVersion1 identifies actions v1, which use a UUID for the id.
+	ActionVersion1 = 1
+	// ActionVersion2 identifies actions v2, which use a number for the id.
+	ActionVersion2 = 2
+)
+
 type ActionTag struct {
 	// Tags that are serialized need to have fields exported.
 	ID string
@@ -32,7 +41,7 @@ func NewActionTag(id string) ActionTag {
 	}
 
 	// Actions v2 use a number.
-	if !validActionV2.MatchString(id) {
+	if !IsValidActionV2(id) {
 		panic(fmt.Sprintf("invalid action id %q", id))
 	}
 	return ActionTag{ID: id}
@@ -40,55 +49,96 @@ func NewActionTag(id string) ActionTag {
 
 // ParseActionTag parses an action tag string.
 func ParseActionTag(actionTag string) (ActionTag, error) {
-	tag, err := ParseTag(actionTag)
+	return ParseAs[ActionTag](actionTag)
+}
+
+func (t ActionTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ActionTag) Kind() string   { return ActionTagKind }
+func (t ActionTag) Id() string     { return t.ID }
+
+// Version returns ActionVersion2 if the action id is a number,
+// ActionVersionUnknown if t is zero, and ActionVersion1 otherwise.
+func (t ActionTag) Version() int {
+	if t.ID == "" {
+		return ActionVersionUnknown
+	}
+	if validActionV2.MatchString(t.ID) {
+		return ActionVersion2
+	}
+	return ActionVersion1
+}
+
+// Sequence returns the number of an actions v2 tag, and a boolean
+// indicating whether or not the tag has a number. Valid actions v2 ids
+// always fit in a uint64.
+func (t ActionTag) Sequence() (uint64, bool) {
+	if t.Version() != ActionVersion2 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(t.ID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// ParseActionTagV2 parses an action tag string, rejecting actions v1
+// tags which use a UUID for the id.
+func ParseActionTagV2(actionTag string) (ActionTag, error) {
+	at, err := ParseActionTag(actionTag)
 	if err != nil {
 		return ActionTag{}, err
 	}
-	at, ok := tag.(ActionTag)
-	if !ok {
+	if at.Version() != ActionVersion2 {
 		return ActionTag{}, invalidTagError(actionTag, ActionTagKind)
 	}
 	return at, nil
 }
 
-func (t ActionTag) String() string { return t.Kind() + "-" + t.Id() }
-func (t ActionTag) Kind() string   { return ActionTagKind }
-func (t ActionTag) Id() string     { return t.ID }
-
 // IsValidAction returns whether id is a valid action id.
 func IsValidAction(id string) bool {
 	// UUID is for actions v1
 	// N is for actions V2.
 	return utils.IsValidUUIDString(id) ||
-		validActionV2.MatchString(id)
+		IsValidActionV2(id)
+}
+
+// IsValidActionV2 returns whether id is a valid actions v2 id: a number
+// that fits in a uint64. Unlike IsValidAction, actions v1 UUIDs are
+// rejected.
+func IsValidActionV2(id string) bool {
+	if !validActionV2.MatchString(id) {
+		return false
+	}
+	_, err := strconv.ParseUint(id, 10, 64)
+	return err == nil
 }
 
 // ActionReceiverTag returns an ActionReceiver Tag from a
-// machine or unit name.
+// machine or unit name. It is NewActionReceiver without application
+// receivers, and any failure is reported as an *ActionReceiverError.
 func ActionReceiverTag(name string) (Tag, error) {
-	if IsValidUnit(name) {
-		return NewUnitTag(name), nil
-	}
-	if IsValidApplication(name) {
-		// TODO(jcw4) enable when leader elections complete
-		//return NewApplicationTag(name), nil
-	}
-	if IsValidMachine(name) {
-		return NewMachineTag(name), nil
-	}
-	return nil, fmt.Errorf("invalid actionreceiver name %q", name)
+	return NewActionReceiver(name, ActionReceiverOptions{})
 }
 
-// ActionReceiverFrom Tag returns an ActionReceiver tag from
-// a machine or unit tag.
+// ActionReceiverFromTag returns an ActionReceiver tag from a machine or
+// unit tag. It is ParseActionReceiverTag without application receivers,
+// and any failure is reported as an *ActionReceiverError.
 func ActionReceiverFromTag(tag string) (Tag, error) {
-	unitTag, err := ParseUnitTag(tag)
-	if err == nil {
-		return unitTag, nil
-	}
-	machineTag, err := ParseMachineTag(tag)
-	if err == nil {
-		return machineTag, nil
+	return ParseActionReceiverTag(tag, ActionReceiverOptions{})
+}
+
+// TryNewActionTag returns the tag for the given id, or an error if id is
+// not a valid action id.
+func TryNewActionTag(id string) (ActionTag, error) {
+	if !IsValidAction(id) {
+		return ActionTag{}, fmt.Errorf("invalid action id %q", id)
 	}
-	return nil, errors.Errorf("invalid actionreceiver tag %q", tag)
+	return NewActionTag(id), nil
+}
+
+// MustNewActionTag returns the tag for the given id.
+// It will panic if id is not a valid action id.
+func MustNewActionTag(id string) ActionTag {
+	return mustTag(TryNewActionTag(id))
 }
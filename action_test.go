@@ -40,13 +40,63 @@ func (s *actionSuite) TestParseActionTag(c *gc.C) {
 	}
 }
 
+func (s *actionSuite) TestVersion(c *gc.C) {
+	v1 := names.NewActionTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(v1.Version(), gc.Equals, names.ActionVersion1)
+	_, ok := v1.Sequence()
+	c.Assert(ok, jc.IsFalse)
+
+	v2 := names.NewActionTag("42")
+	c.Assert(v2.Version(), gc.Equals, names.ActionVersion2)
+	n, ok := v2.Sequence()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(n, gc.Equals, uint64(42))
+
+	max := names.NewActionTag("18446744073709551615")
+	n, ok = max.Sequence()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(n, gc.Equals, uint64(18446744073709551615))
+
+	c.Assert(names.ActionTag{}.Version(), gc.Equals, names.ActionVersionUnknown)
+	_, ok = names.ActionTag{}.Sequence()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *actionSuite) TestIsValidActionV2(c *gc.C) {
+	c.Assert(names.IsValidActionV2("0"), jc.IsTrue)
+	c.Assert(names.IsValidActionV2("42"), jc.IsTrue)
+	c.Assert(names.IsValidActionV2("042"), jc.IsFalse)
+	c.Assert(names.IsValidActionV2("18446744073709551615"), jc.IsTrue)
+	c.Assert(names.IsValidActionV2("99999999999999999999"), jc.IsFalse)
+	c.Assert(names.IsValidAction("99999999999999999999"), jc.IsFalse)
+	c.Assert(names.IsValidActionV2("f47ac10b-58cc-4372-a567-0e02b2c3d479"), jc.IsFalse)
+}
+
+func (s *actionSuite) TestParseActionTagV2(c *gc.C) {
+	tag, err := names.ParseActionTagV2("action-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tag, gc.Equals, names.NewActionTag("1"))
+
+	_, err = names.ParseActionTagV2("action-f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("action-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.ActionTagKind))
+
+	_, err = names.ParseActionTagV2("action-99999999999999999999")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("action-99999999999999999999", names.ActionTagKind))
+	_, err = names.ParseTag("action-99999999999999999999")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("action-99999999999999999999", names.ActionTagKind))
+
+	_, err = names.ParseActionTagV2("operation-1")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("operation-1", names.ActionTagKind))
+}
+
 func (s *actionSuite) TestActionReceiverTag(c *gc.C) {
 	testCases := []struct {
 		name     string
 		expected names.Tag
 		err      string
 	}{
-		{name: "mysql", err: `invalid actionreceiver name "mysql"`},
+		{name: "mysql", err: `invalid actionreceiver "mysql" \(.*application: application action receivers not allowed\)`},
+		{name: "mysql/x", err: `invalid actionreceiver "mysql/x" \(unit: "mysql/x" is not a valid unit name; .*\)`},
 		{name: "mysql/3", expected: names.NewUnitTag("mysql/3")},
 		{name: "3", expected: names.NewMachineTag("3")},
 	}
@@ -71,7 +121,8 @@ func (s *actionSuite) TestActionReceiverFromTag(c *gc.C) {
 		expected names.Tag
 		err      string
 	}{
-		{name: "rambleon", err: `invalid actionreceiver tag "rambleon"`},
+		{name: "rambleon", err: `invalid actionreceiver "rambleon" \(unit: "rambleon" is not a valid tag; .*\)`},
+		{name: "application-mysql", err: `invalid actionreceiver "application-mysql" \(.*application: application action receivers not allowed\)`},
 		{name: "unit-mysql-2", expected: names.NewUnitTag("mysql/2")},
 		{name: "machine-13", expected: names.NewMachineTag("13")},
 	} {
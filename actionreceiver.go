@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ActionReceiver is a Tag for an entity that actions can be run on.
+// It is implemented by UnitTag, MachineTag and ApplicationTag, the
+// latter meaning the application's leader unit.
+type ActionReceiver interface {
+	Tag
+	actionReceiver()
+}
+
+func (UnitTag) actionReceiver()        {}
+func (MachineTag) actionReceiver()     {}
+func (ApplicationTag) actionReceiver() {}
+
+// ActionReceiverOptions controls which kinds of entity are accepted
+// as action receivers.
+type ActionReceiverOptions struct {
+	// AllowApplication allows applications to be action receivers,
+	// targeting the leader unit of the application.
+	AllowApplication bool
+}
+
+// ActionReceiverAttempt records why a value could not be used as an
+// action receiver of a particular kind.
+type ActionReceiverAttempt struct {
+	Kind string
+	Err  error
+}
+
+// ActionReceiverError is returned when a value cannot be used as an
+// action receiver. It records each kind that was attempted, in order.
+type ActionReceiverError struct {
+	Value    string
+	Attempts []ActionReceiverAttempt
+}
+
+// Error implements error.
+func (e *ActionReceiverError) Error() string {
+	reasons := make([]string, len(e.Attempts))
+	for i, attempt := range e.Attempts {
+		reasons[i] = attempt.Kind + ": " + attempt.Err.Error()
+	}
+	return fmt.Sprintf("invalid actionreceiver %q (%s)", e.Value, strings.Join(reasons, "; "))
+}
+
+var errApplicationReceiverNotAllowed = fmt.Errorf("application action receivers not allowed")
+
+// NewActionReceiver returns the ActionReceiver for the given unit,
+// machine or application name. Application names are only accepted
+// if opts.AllowApplication is set. Any failure is reported as an
+// *ActionReceiverError.
+func NewActionReceiver(name string, opts ActionReceiverOptions) (ActionReceiver, error) {
+	e := &ActionReceiverError{Value: name}
+	if IsValidUnit(name) {
+		return NewUnitTag(name), nil
+	}
+	e.Attempts = append(e.Attempts, ActionReceiverAttempt{UnitTagKind, fmt.Errorf("%q is not a valid unit name", name)})
+
+	if IsValidMachine(name) {
+		return NewMachineTag(name), nil
+	}
+	e.Attempts = append(e.Attempts, ActionReceiverAttempt{MachineTagKind, fmt.Errorf("%q is not a valid machine id", name)})
+
+	switch {
+	case !IsValidApplication(name):
+		e.Attempts = append(e.Attempts, ActionReceiverAttempt{ApplicationTagKind, fmt.Errorf("%q is not a valid application name", name)})
+	case !opts.AllowApplication:
+		e.Attempts = append(e.Attempts, ActionReceiverAttempt{ApplicationTagKind, errApplicationReceiverNotAllowed})
+	default:
+		return NewApplicationTag(name), nil
+	}
+	return nil, e
+}
+
+// ParseActionReceiverTag parses a unit, machine or application tag
+// string into an ActionReceiver. Application tags are only accepted
+// if opts.AllowApplication is set. Any failure is reported as an
+// *ActionReceiverError.
+func ParseActionReceiverTag(tag string, opts ActionReceiverOptions) (ActionReceiver, error) {
+	e := &ActionReceiverError{Value: tag}
+	unitTag, err := ParseUnitTag(tag)
+	if err == nil {
+		return unitTag, nil
+	}
+	e.Attempts = append(e.Attempts, ActionReceiverAttempt{UnitTagKind, err})
+
+	machineTag, err := ParseMachineTag(tag)
+	if err == nil {
+		return machineTag, nil
+	}
+	e.Attempts = append(e.Attempts, ActionReceiverAttempt{MachineTagKind, err})
+
+	applicationTag, err := ParseApplicationTag(tag)
+	switch {
+	case err != nil:
+		e.Attempts = append(e.Attempts, ActionReceiverAttempt{ApplicationTagKind, err})
+	case !opts.AllowApplication:
+		e.Attempts = append(e.Attempts, ActionReceiverAttempt{ApplicationTagKind, errApplicationReceiverNotAllowed})
+	default:
+		return applicationTag, nil
+	}
+	return nil, e
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type actionReceiverSuite struct{}
+
+var _ = gc.Suite(&actionReceiverSuite{})
+
+var (
+	_ names.ActionReceiver = names.UnitTag{}
+	_ names.ActionReceiver = names.MachineTag{}
+	_ names.ActionReceiver = names.ApplicationTag{}
+)
+
+func (s *actionReceiverSuite) TestNewActionReceiver(c *gc.C) {
+	allow := names.ActionReceiverOptions{AllowApplication: true}
+	for i, test := range []struct {
+		name     string
+		opts     names.ActionReceiverOptions
+		expected names.ActionReceiver
+		err      string
+	}{
+		{name: "mysql/3", expected: names.NewUnitTag("mysql/3")},
+		{name: "3", expected: names.NewMachineTag("3")},
+		{name: "3/lxd/0", expected: names.NewMachineTag("3/lxd/0")},
+		{name: "mysql", opts: allow, expected: names.NewApplicationTag("mysql")},
+		{
+			name: "mysql",
+			err:  `invalid actionreceiver "mysql" \(unit: .*; machine: .*; application: application action receivers not allowed\)`,
+		}, {
+			name: "Mysql",
+			opts: allow,
+			err:  `invalid actionreceiver "Mysql" \(unit: "Mysql" is not a valid unit name; machine: "Mysql" is not a valid machine id; application: "Mysql" is not a valid application name\)`,
+		},
+	} {
+		c.Logf("test %d: %q", i, test.name)
+		receiver, err := names.NewActionReceiver(test.name, test.opts)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(err, gc.FitsTypeOf, &names.ActionReceiverError{})
+			c.Check(receiver, gc.IsNil)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(receiver, gc.Equals, test.expected)
+	}
+}
+
+func (s *actionReceiverSuite) TestParseActionReceiverTag(c *gc.C) {
+	allow := names.ActionReceiverOptions{AllowApplication: true}
+	for i, test := range []struct {
+		tag      string
+		opts     names.ActionReceiverOptions
+		expected names.ActionReceiver
+		err      string
+	}{
+		{tag: "unit-mysql-3", expected: names.NewUnitTag("mysql/3")},
+		{tag: "machine-3-lxd-0", expected: names.NewMachineTag("3/lxd/0")},
+		{tag: "application-mysql", opts: allow, expected: names.NewApplicationTag("mysql")},
+		{
+			tag: "application-mysql",
+			err: `invalid actionreceiver "application-mysql" \(unit: .*; machine: .*; application: application action receivers not allowed\)`,
+		}, {
+			tag:  "user-bob",
+			opts: allow,
+			err:  `invalid actionreceiver "user-bob" \(unit: "user-bob" is not a valid unit tag; machine: "user-bob" is not a valid machine tag; application: "user-bob" is not a valid application tag\)`,
+		},
+	} {
+		c.Logf("test %d: %q", i, test.tag)
+		receiver, err := names.ParseActionReceiverTag(test.tag, test.opts)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(receiver, gc.IsNil)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(receiver, gc.Equals, test.expected)
+	}
+}
+
+func (s *actionReceiverSuite) TestActionReceiverErrorAttempts(c *gc.C) {
+	_, err := names.ParseActionReceiverTag("application-mysql", names.ActionReceiverOptions{})
+	arErr, ok := err.(*names.ActionReceiverError)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(arErr.Value, gc.Equals, "application-mysql")
+	kinds := make([]string, len(arErr.Attempts))
+	for i, attempt := range arErr.Attempts {
+		kinds[i] = attempt.Kind
+	}
+	c.Assert(kinds, jc.DeepEquals, []string{names.UnitTagKind, names.MachineTagKind, names.ApplicationTagKind})
+}
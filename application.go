@@ -4,6 +4,7 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"unicode"
@@ -91,13 +92,20 @@ func NewApplicationTag(applicationName string) ApplicationTag {
 
 // ParseApplicationTag parses a application tag string.
 func ParseApplicationTag(applicationTag string) (ApplicationTag, error) {
-	tag, err := ParseTag(applicationTag)
-	if err != nil {
-		return ApplicationTag{}, err
-	}
-	st, ok := tag.(ApplicationTag)
-	if !ok {
-		return ApplicationTag{}, invalidTagError(applicationTag, ApplicationTagKind)
+	return ParseAs[ApplicationTag](applicationTag)
+}
+
+// TryNewApplicationTag returns the tag for the given name, or an error if name is
+// not a valid application name.
+func TryNewApplicationTag(name string) (ApplicationTag, error) {
+	if !IsValidApplication(name) {
+		return ApplicationTag{}, fmt.Errorf("%q is not a valid application name", name)
 	}
-	return st, nil
+	return NewApplicationTag(name), nil
+}
+
+// MustNewApplicationTag returns the tag for the given name.
+// It will panic if name is not a valid application name.
+func MustNewApplicationTag(name string) ApplicationTag {
+	return mustTag(TryNewApplicationTag(name))
 }
@@ -3,11 +3,13 @@
 
 package names
 
+import "fmt"
+
 const ApplicationOfferTagKind = "applicationoffer"
 
 // IsValidApplicationOffer returns whether name is a valid application offer name.
 func IsValidApplicationOffer(uuid string) bool {
-	return validUUID.MatchString(uuid)
+	return isValidUUID(uuid)
 }
 
 type ApplicationOfferTag struct {
@@ -25,13 +27,20 @@ func NewApplicationOfferTag(applicationOfferName string) ApplicationOfferTag {
 
 // ParseApplicationOfferTag parses a application tag string.
 func ParseApplicationOfferTag(applicationOfferTag string) (ApplicationOfferTag, error) {
-	tag, err := ParseTag(applicationOfferTag)
-	if err != nil {
-		return ApplicationOfferTag{}, err
-	}
-	st, ok := tag.(ApplicationOfferTag)
-	if !ok {
-		return ApplicationOfferTag{}, invalidTagError(applicationOfferTag, ApplicationOfferTagKind)
+	return ParseAs[ApplicationOfferTag](applicationOfferTag)
+}
+
+// TryNewApplicationOfferTag returns the tag of an application offer with the given UUID,
+// or an error if uuid is not valid.
+func TryNewApplicationOfferTag(uuid string) (ApplicationOfferTag, error) {
+	if !IsValidApplicationOffer(uuid) {
+		return ApplicationOfferTag{}, fmt.Errorf("%q is not a valid application offer UUID", uuid)
 	}
-	return st, nil
+	return ApplicationOfferTag{Name: uuid}, nil
+}
+
+// MustNewApplicationOfferTag returns the tag of an application offer with the given UUID.
+// It will panic if uuid is not valid.
+func MustNewApplicationOfferTag(uuid string) ApplicationOfferTag {
+	return mustTag(TryNewApplicationOfferTag(uuid))
 }
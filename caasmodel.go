@@ -3,6 +3,8 @@
 
 package names
 
+import "fmt"
+
 const CAASModelTagKind = "caasmodel"
 
 // CAASModelTag represents a tag used to describe a model.
@@ -17,15 +19,7 @@ func NewCAASModelTag(uuid string) CAASModelTag {
 
 // ParseCAASModelTag parses a CAAS model tag string.
 func ParseCAASModelTag(caasModelTag string) (CAASModelTag, error) {
-	tag, err := ParseTag(caasModelTag)
-	if err != nil {
-		return CAASModelTag{}, err
-	}
-	cmt, ok := tag.(CAASModelTag)
-	if !ok {
-		return CAASModelTag{}, invalidTagError(caasModelTag, CAASModelTagKind)
-	}
-	return cmt, nil
+	return ParseAs[CAASModelTag](caasModelTag)
 }
 
 func (t CAASModelTag) String() string { return t.Kind() + "-" + t.Id() }
@@ -34,10 +28,25 @@ func (t CAASModelTag) Id() string     { return t.uuid }
 
 // IsValidCAASModel returns whether id is a valid CAAS model UUID.
 func IsValidCAASModel(id string) bool {
-	return validUUID.MatchString(id)
+	return isValidUUID(id)
 }
 
 // IsValidCAASModelName returns whether name is a valid string safe for a CAAS model name.
 func IsValidCAASModelName(name string) bool {
 	return validModelName.MatchString(name)
 }
+
+// TryNewCAASModelTag returns the tag of a CAAS model with the given UUID,
+// or an error if uuid is not valid.
+func TryNewCAASModelTag(uuid string) (CAASModelTag, error) {
+	if !IsValidCAASModel(uuid) {
+		return CAASModelTag{}, fmt.Errorf("%q is not a valid CAAS model UUID", uuid)
+	}
+	return CAASModelTag{uuid: uuid}, nil
+}
+
+// MustNewCAASModelTag returns the tag of a CAAS model with the given UUID.
+// It will panic if uuid is not valid.
+func MustNewCAASModelTag(uuid string) CAASModelTag {
+	return mustTag(TryNewCAASModelTag(uuid))
+}
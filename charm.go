@@ -0,0 +1,252 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CharmTagKind is used as the prefix for the string representation
+// of charm tags.
+const CharmTagKind = "charm"
+
+const (
+	// CharmNameSnippet is a non-compiled regexp that can be composed with
+	// other snippets for validating charm names. Charm names follow the
+	// same rules as application names.
+	CharmNameSnippet = ApplicationSnippet
+
+	// CharmSeriesSnippet is a non-compiled regexp that can be composed
+	// with other snippets for validating the series ("jammy") or base
+	// ("ubuntu@22.04") of a charm URL.
+	CharmSeriesSnippet = "(?:[a-z][a-z0-9]*(?:@[0-9]+(?:\\.[0-9]+)*)?)"
+
+	// CharmArchitectureSnippet is a non-compiled regexp that can be
+	// composed with other snippets for validating charm architectures.
+	CharmArchitectureSnippet = "(?:amd64|arm64|ppc64el|s390x|riscv64)"
+)
+
+const (
+	// CharmHubSchema is the schema of charms from Charmhub.
+	CharmHubSchema = "ch"
+	// LocalSchema is the schema of charms deployed from a local path.
+	LocalSchema = "local"
+	// legacyCharmStoreSchema is the schema of charms from the retired
+	// charm store. It is only accepted by ParseCharmURLLenient.
+	legacyCharmStoreSchema = "cs"
+)
+
+var validCharmURL = regexp.MustCompile(
+	"^(?:([a-z]+):)?" +
+		"(?:(" + CharmArchitectureSnippet + ")/)?" +
+		"(?:(" + CharmSeriesSnippet + ")/)?" +
+		"(" + CharmNameSnippet + ")" +
+		"(?:-(" + NumberSnippet + "))?$",
+)
+
+var validCharmArchitecture = regexp.MustCompile("^" + CharmArchitectureSnippet + "$")
+
+// CharmURL identifies a charm. Its canonical string form is
+// "<schema>:[<architecture>/][<series>/]<name>[-<revision>]", for
+// example "ch:amd64/jammy/mysql-42" or "local:focal/foo-3".
+//
+// CharmURLs should be made with ParseCharmURL or ParseCharmURLLenient.
+// A CharmURL built by hand must set Revision to -1 when there is no
+// revision, and should be checked with Validate before it is used.
+type CharmURL struct {
+	Schema       string
+	Architecture string
+
+	// Series holds either a series such as "jammy" or a base such
+	// as "ubuntu@22.04". It is empty if not specified.
+	Series string
+
+	Name string
+
+	// Revision is -1 if not specified. Its zero value, 0, is a
+	// valid revision.
+	Revision int
+}
+
+// ParseCharmURL parses a charm URL string. The schema must be given and
+// must be either CharmHubSchema or LocalSchema.
+func ParseCharmURL(s string) (CharmURL, error) {
+	u, err := parseCharmURL(s)
+	if err != nil {
+		return CharmURL{}, err
+	}
+	if u.Schema != CharmHubSchema && u.Schema != LocalSchema {
+		return CharmURL{}, fmt.Errorf("%q is not a valid charm URL: schema %q not supported", s, u.Schema)
+	}
+	return u, nil
+}
+
+// ParseCharmURLLenient parses a charm URL string as typed by a user.
+// A missing schema defaults to CharmHubSchema, and the legacy charm
+// store schema "cs" is read as CharmHubSchema.
+func ParseCharmURLLenient(s string) (CharmURL, error) {
+	u, err := parseCharmURL(s)
+	if err != nil {
+		return CharmURL{}, err
+	}
+	switch u.Schema {
+	case "", legacyCharmStoreSchema:
+		u.Schema = CharmHubSchema
+	case CharmHubSchema, LocalSchema:
+	default:
+		return CharmURL{}, fmt.Errorf("%q is not a valid charm URL: schema %q not supported", s, u.Schema)
+	}
+	return u, nil
+}
+
+func parseCharmURL(s string) (CharmURL, error) {
+	parts := validCharmURL.FindStringSubmatch(s)
+	if parts == nil {
+		return CharmURL{}, fmt.Errorf("%q is not a valid charm URL", s)
+	}
+	if validCharmArchitecture.MatchString(parts[3]) {
+		// Otherwise "ch:amd64/amd64/mysql" would have the series "amd64".
+		return CharmURL{}, fmt.Errorf("%q is not a valid charm URL: series %q is an architecture", s, parts[3])
+	}
+	u := CharmURL{
+		Schema:       parts[1],
+		Architecture: parts[2],
+		Series:       parts[3],
+		Name:         parts[4],
+		Revision:     -1,
+	}
+	if parts[5] != "" {
+		rev, err := strconv.Atoi(parts[5])
+		if err != nil {
+			return CharmURL{}, fmt.Errorf("%q is not a valid charm URL: invalid revision", s)
+		}
+		u.Revision = rev
+	}
+	return u, nil
+}
+
+// WithRevision returns a copy of the URL with the given revision.
+// A negative revision means no revision.
+func (u CharmURL) WithRevision(revision int) CharmURL {
+	if revision < 0 {
+		revision = -1
+	}
+	u.Revision = revision
+	return u
+}
+
+// Validate returns an error if u is not a URL that ParseCharmURL could
+// have returned, such as one without a schema or name, or one whose
+// string form would be read back differently.
+func (u CharmURL) Validate() error {
+	s := u.String()
+	parsed, err := ParseCharmURL(s)
+	if err != nil {
+		return err
+	}
+	if parsed != u {
+		return fmt.Errorf("%q is not a valid charm URL: it does not match %#v", s, u)
+	}
+	return nil
+}
+
+// String returns the canonical string form of the URL. It returns the
+// empty string if u is zero. The result is only a valid charm URL if
+// Validate returns no error.
+func (u CharmURL) String() string {
+	if u == (CharmURL{}) {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(u.Schema)
+	b.WriteString(":")
+	if u.Architecture != "" {
+		b.WriteString(u.Architecture + "/")
+	}
+	if u.Series != "" {
+		b.WriteString(u.Series + "/")
+	}
+	b.WriteString(u.Name)
+	if u.Revision >= 0 {
+		b.WriteString("-" + strconv.Itoa(u.Revision))
+	}
+	return b.String()
+}
+
+// Tag returns the tag of the charm identified by the URL.
+// It will panic if the URL is not valid.
+func (u CharmURL) Tag() CharmTag {
+	return NewCharmTag(u.String())
+}
+
+// IsValidCharm returns whether id is a valid charm URL, as accepted
+// by ParseCharmURL.
+func IsValidCharm(id string) bool {
+	_, err := ParseCharmURL(id)
+	return err == nil
+}
+
+// CharmTag represents a charm. Its id is the canonical charm URL.
+type CharmTag struct {
+	url CharmURL
+}
+
+// String implements Tag. The ":" and "/" separators of the URL are both
+// written as "_", which appears nowhere else in a charm URL.
+func (t CharmTag) String() string {
+	return t.Kind() + "-" + strings.NewReplacer(":", "_", "/", "_").Replace(t.Id())
+}
+
+// Kind implements Tag.
+func (t CharmTag) Kind() string { return CharmTagKind }
+
+// Id implements Tag.
+func (t CharmTag) Id() string {
+	if t.url == (CharmURL{}) {
+		return ""
+	}
+	return t.url.String()
+}
+
+// URL returns the charm URL of the tag.
+func (t CharmTag) URL() CharmURL { return t.url }
+
+// NewCharmTag returns the tag for the charm with the given URL.
+// It will panic if the given URL is not valid.
+func NewCharmTag(url string) CharmTag {
+	u, err := ParseCharmURL(url)
+	if err != nil {
+		panic(err.Error())
+	}
+	return CharmTag{url: u}
+}
+
+// ParseCharmTag parses a charm tag string.
+func ParseCharmTag(charmTag string) (CharmTag, error) {
+	return ParseAs[CharmTag](charmTag)
+}
+
+func charmTagSuffixToId(s string) string {
+	s = strings.Replace(s, "_", ":", 1)
+	return strings.Replace(s, "_", "/", -1)
+}
+
+// TryNewCharmTag returns the tag for the given charm URL, or an error if
+// url is not a valid charm URL.
+func TryNewCharmTag(url string) (CharmTag, error) {
+	u, err := ParseCharmURL(url)
+	if err != nil {
+		return CharmTag{}, err
+	}
+	return CharmTag{url: u}, nil
+}
+
+// MustNewCharmTag returns the tag for the given charm URL.
+// It will panic if url is not a valid charm URL.
+func MustNewCharmTag(url string) CharmTag {
+	return mustTag(TryNewCharmTag(url))
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type charmSuite struct{}
+
+var _ = gc.Suite(&charmSuite{})
+
+var charmURLTests = []struct {
+	url       string
+	expected  names.CharmURL
+	canonical string
+	lenient   bool
+	err       string
+}{{
+	url:      "ch:amd64/jammy/mysql-42",
+	expected: names.CharmURL{Schema: "ch", Architecture: "amd64", Series: "jammy", Name: "mysql", Revision: 42},
+}, {
+	url:      "local:focal/foo-3",
+	expected: names.CharmURL{Schema: "local", Series: "focal", Name: "foo", Revision: 3},
+}, {
+	url:      "ch:arm64/rabbitmq-server",
+	expected: names.CharmURL{Schema: "ch", Architecture: "arm64", Name: "rabbitmq-server", Revision: -1},
+}, {
+	url:      "ch:amd64/ubuntu@22.04/postgresql-k8s-0",
+	expected: names.CharmURL{Schema: "ch", Architecture: "amd64", Series: "ubuntu@22.04", Name: "postgresql-k8s", Revision: 0},
+}, {
+	url:      "ch:mysql",
+	expected: names.CharmURL{Schema: "ch", Name: "mysql", Revision: -1},
+}, {
+	url:       "mysql",
+	lenient:   true,
+	expected:  names.CharmURL{Schema: "ch", Name: "mysql", Revision: -1},
+	canonical: "ch:mysql",
+}, {
+	url:       "cs:trusty/mysql-2",
+	lenient:   true,
+	expected:  names.CharmURL{Schema: "ch", Series: "trusty", Name: "mysql", Revision: 2},
+	canonical: "ch:trusty/mysql-2",
+}, {
+	url: "mysql",
+	err: `"mysql" is not a valid charm URL: schema "" not supported`,
+}, {
+	url: "cs:mysql",
+	err: `"cs:mysql" is not a valid charm URL: schema "cs" not supported`,
+}, {
+	url:     "http:mysql",
+	lenient: true,
+	err:     `"http:mysql" is not a valid charm URL: schema "http" not supported`,
+}, {
+	url: "ch:Mysql",
+	err: `"ch:Mysql" is not a valid charm URL`,
+}, {
+	url: "ch:mysql-01",
+	err: `"ch:mysql-01" is not a valid charm URL`,
+}, {
+	url: "ch:sparc/jammy/mysql",
+	err: `"ch:sparc/jammy/mysql" is not a valid charm URL`,
+}, {
+	url:     "ch:amd64/jammy/mysql/",
+	lenient: true,
+	err:     `"ch:amd64/jammy/mysql/" is not a valid charm URL`,
+}, {
+	url: "ch:amd64/amd64/mysql",
+	err: `"ch:amd64/amd64/mysql" is not a valid charm URL: series "amd64" is an architecture`,
+}}
+
+func (s *charmSuite) TestParseCharmURL(c *gc.C) {
+	for i, test := range charmURLTests {
+		c.Logf("test %d: %q", i, test.url)
+		parse := names.ParseCharmURL
+		if test.lenient {
+			parse = names.ParseCharmURLLenient
+		}
+		u, err := parse(test.url)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(u, gc.Equals, test.expected)
+		canonical := test.canonical
+		if canonical == "" {
+			canonical = test.url
+		}
+		c.Check(u.String(), gc.Equals, canonical)
+		c.Check(names.IsValidCharm(canonical), jc.IsTrue)
+	}
+}
+
+func (s *charmSuite) TestWithRevision(c *gc.C) {
+	u, err := names.ParseCharmURL("ch:amd64/jammy/mysql-42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(u.WithRevision(43).String(), gc.Equals, "ch:amd64/jammy/mysql-43")
+	c.Assert(u.WithRevision(-5).String(), gc.Equals, "ch:amd64/jammy/mysql")
+}
+
+func (s *charmSuite) TestValidate(c *gc.C) {
+	u, err := names.ParseCharmURL("ch:amd64/jammy/mysql-42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(u.Validate(), jc.ErrorIsNil)
+	c.Assert(u.WithRevision(-1).Validate(), jc.ErrorIsNil)
+
+	for i, test := range []struct {
+		url names.CharmURL
+		err string
+	}{{
+		url: names.CharmURL{},
+		err: `"" is not a valid charm URL`,
+	}, {
+		url: names.CharmURL{Name: "mysql"},
+		err: `":mysql-0" is not a valid charm URL`,
+	}, {
+		url: names.CharmURL{Schema: "cs", Name: "mysql", Revision: -1},
+		err: `"cs:mysql" is not a valid charm URL: schema "cs" not supported`,
+	}, {
+		url: names.CharmURL{Schema: "ch", Series: "amd64", Name: "mysql", Revision: -1},
+		err: `"ch:amd64/mysql" is not a valid charm URL: it does not match .*`,
+	}, {
+		url: names.CharmURL{Schema: "ch", Name: "mysql", Revision: -2},
+		err: `"ch:mysql" is not a valid charm URL: it does not match .*`,
+	}} {
+		c.Logf("test %d: %#v", i, test.url)
+		c.Check(test.url.Validate(), gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *charmSuite) TestZeroString(c *gc.C) {
+	c.Assert(names.CharmURL{}.String(), gc.Equals, "")
+	c.Assert(names.CharmURL{Schema: "ch", Name: "mysql"}.String(), gc.Equals, "ch:mysql-0")
+}
+
+func (s *charmSuite) TestCharmTag(c *gc.C) {
+	tag := names.NewCharmTag("ch:amd64/ubuntu@22.04/mysql-42")
+	c.Assert(tag.String(), gc.Equals, "charm-ch_amd64_ubuntu@22.04_mysql-42")
+	c.Assert(tag.Id(), gc.Equals, "ch:amd64/ubuntu@22.04/mysql-42")
+	c.Assert(tag.URL().Name, gc.Equals, "mysql")
+
+	parsed, err := names.ParseCharmTag(tag.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(parsed, gc.Equals, tag)
+
+	u, err := names.ParseCharmURL("local:focal/foo-3")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(u.Tag(), gc.Equals, names.NewCharmTag("local:focal/foo-3"))
+}
+
+func (s *charmSuite) TestNewCharmTagInvalid(c *gc.C) {
+	c.Assert(func() { names.NewCharmTag("mysql") }, gc.PanicMatches, `"mysql" is not a valid charm URL: .*`)
+}
+
+var parseCharmTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{
+	{tag: "", err: names.InvalidTagError("", "")},
+	{tag: "charm-ch_mysql-1", expected: names.NewCharmTag("ch:mysql-1")},
+	{tag: "charm-ch_mysql_1", err: names.InvalidTagError("charm-ch_mysql_1", names.CharmTagKind)},
+	{tag: "application-mysql", err: names.InvalidTagError("application-mysql", names.CharmTagKind)},
+}
+
+func (s *charmSuite) TestParseCharmTag(c *gc.C) {
+	for i, t := range parseCharmTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCharmTag(t.tag)
+		if t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
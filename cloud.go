@@ -44,18 +44,34 @@ func NewCloudTag(id string) CloudTag {
 
 // ParseCloudTag parses a cloud tag string.
 func ParseCloudTag(cloudTag string) (CloudTag, error) {
-	tag, err := ParseTag(cloudTag)
-	if err != nil {
-		return CloudTag{}, err
-	}
-	dt, ok := tag.(CloudTag)
-	if !ok {
-		return CloudTag{}, invalidTagError(cloudTag, CloudTagKind)
-	}
-	return dt, nil
+	return ParseAs[CloudTag](cloudTag)
 }
 
 // IsValidCloud returns whether id is a valid cloud ID.
 func IsValidCloud(id string) bool {
 	return validCloud.MatchString(id)
 }
+
+// Region returns the tag for the named region of the cloud.
+// It will panic if region is not a valid region name.
+func (t CloudTag) Region(region string) CloudRegionTag {
+	if !IsValidCloudRegionName(region) {
+		panic(fmt.Sprintf("%q is not a valid cloud region name", region))
+	}
+	return CloudRegionTag{cloud: t, region: region}
+}
+
+// TryNewCloudTag returns the tag for the given id, or an error if id is
+// not a valid cloud ID.
+func TryNewCloudTag(id string) (CloudTag, error) {
+	if !IsValidCloud(id) {
+		return CloudTag{}, fmt.Errorf("%q is not a valid cloud ID", id)
+	}
+	return NewCloudTag(id), nil
+}
+
+// MustNewCloudTag returns the tag for the given id.
+// It will panic if id is not a valid cloud ID.
+func MustNewCloudTag(id string) CloudTag {
+	return mustTag(TryNewCloudTag(id))
+}
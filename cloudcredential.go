@@ -47,10 +47,52 @@ func (t CloudCredentialTag) Id() string {
 	return fmt.Sprintf("%s/%s/%s", t.cloud.Id(), t.owner.Id(), t.name)
 }
 
+// quoteCredentialSeparator escapes "_" as "%5f" in one part of a cloud
+// credential tag, which has the format "cloudcred-cloud_owner_name".
+// None of the parts may contain "%", so "%5f" is the only escape
+// sequence a tag can contain.
 func quoteCredentialSeparator(in string) string {
 	return strings.Replace(in, "_", `%5f`, -1)
 }
 
+// decodeTagParts splits a "_" separated tag suffix into exactly n parts,
+// decoding the "%5f" escape in each. As "%5f" is the only escape, this
+// recovers every part written by quoteCredentialSeparator exactly.
+func decodeTagParts(s string, n int) ([]string, error) {
+	parts := strings.Split(s, "_")
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d parts, got %d", n, len(parts))
+	}
+	for i, part := range parts {
+		part = strings.Replace(part, "%5f", "_", -1)
+		part = strings.Replace(part, "%5F", "_", -1)
+		if strings.Contains(part, "%") {
+			return nil, fmt.Errorf("unexpected escape sequence in %q", parts[i])
+		}
+		parts[i] = part
+	}
+	return parts, nil
+}
+
+// decodeLegacyTagParts is like decodeTagParts but accepts any percent
+// escape sequence, as written by older clients. Those decoded tags with
+// url.QueryUnescape, which also turned "+" into " " and so broke owners
+// and names containing "+"; here "+" is left alone.
+func decodeLegacyTagParts(s string, n int) ([]string, error) {
+	parts := strings.Split(s, "_")
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d parts, got %d", n, len(parts))
+	}
+	for i, part := range parts {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = unescaped
+	}
+	return parts, nil
+}
+
 // String implements Tag.String. It returns the empty
 // string if t is zero.
 func (t CloudCredentialTag) String() string {
@@ -79,6 +121,15 @@ func (t CloudCredentialTag) Name() string {
 	return t.name
 }
 
+// InRegion returns the credential bound to the named region of its
+// cloud. It returns an error if region is not a valid region name.
+func (t CloudCredentialTag) InRegion(region string) (CloudRegionCredential, error) {
+	if !IsValidCloudRegionName(region) {
+		return CloudRegionCredential{}, fmt.Errorf("%q is not a valid cloud region name", region)
+	}
+	return NewCloudRegionCredential(CloudRegionTag{cloud: t.cloud, region: region}, t)
+}
+
 // NewCloudCredentialTag returns the tag for the cloud with the given ID.
 // It will panic if the given cloud ID is not valid.
 func NewCloudCredentialTag(id string) CloudCredentialTag {
@@ -93,15 +144,7 @@ func NewCloudCredentialTag(id string) CloudCredentialTag {
 
 // ParseCloudCredentialTag parses a cloud tag string.
 func ParseCloudCredentialTag(s string) (CloudCredentialTag, error) {
-	tag, err := ParseTag(s)
-	if err != nil {
-		return CloudCredentialTag{}, err
-	}
-	dt, ok := tag.(CloudCredentialTag)
-	if !ok {
-		return CloudCredentialTag{}, invalidTagError(s, CloudCredentialTagKind)
-	}
-	return dt, nil
+	return ParseAs[CloudCredentialTag](s)
 }
 
 // IsValidCloudCredential returns whether id is a valid cloud credential ID.
@@ -114,7 +157,34 @@ func IsValidCloudCredentialName(name string) bool {
 	return validCloudCredentialName.MatchString(name)
 }
 
+// cloudCredentialTagSuffixToId returns the id for a cloud credential tag
+// suffix. A suffix without three parts gives an empty id, which is then
+// rejected as invalid; only escapes that cannot be decoded give an error.
 func cloudCredentialTagSuffixToId(s string) (string, error) {
-	s = strings.Replace(s, "_", "/", -1)
-	return url.QueryUnescape(s)
+	if strings.Count(s, "_") != 2 {
+		return "", nil
+	}
+	parts, err := decodeTagParts(s, 3)
+	if err != nil {
+		parts, err = decodeLegacyTagParts(s, 3)
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+// TryNewCloudCredentialTag returns the tag for the given id, or an error if id is
+// not a valid cloud credential ID.
+func TryNewCloudCredentialTag(id string) (CloudCredentialTag, error) {
+	if !IsValidCloudCredential(id) {
+		return CloudCredentialTag{}, fmt.Errorf("%q is not a valid cloud credential ID", id)
+	}
+	return NewCloudCredentialTag(id), nil
+}
+
+// MustNewCloudCredentialTag returns the tag for the given id.
+// It will panic if id is not a valid cloud credential ID.
+func MustNewCloudCredentialTag(id string) CloudCredentialTag {
+	return mustTag(TryNewCloudCredentialTag(id))
 }
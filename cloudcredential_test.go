@@ -4,6 +4,10 @@
 package names_test
 
 import (
+	"net/url"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/names/v6"
@@ -131,6 +135,19 @@ func (s *cloudCredentialSuite) TestParseCloudCredentialTag(c *gc.C) {
 	}, {
 		tag:      `cloudcred-aws-china_bob_foo%5fbar`,
 		expected: names.NewCloudCredentialTag("aws-china/bob/foo_bar"),
+	}, {
+		tag:      "cloudcred-google_bob+bob@remote_foo+bar",
+		expected: names.NewCloudCredentialTag("google/bob+bob@remote/foo+bar"),
+	}, {
+		// Escapes other than %5f are accepted for compatibility.
+		tag:      "cloudcred-google_bob%2bbob%40remote_foo%5Fbar",
+		expected: names.NewCloudCredentialTag("google/bob+bob@remote/foo_bar"),
+	}, {
+		tag: "cloudcred-google_bob%2fx_foo",
+		err: names.InvalidTagError("cloudcred-google_bob%2fx_foo", names.CloudCredentialTagKind),
+	}, {
+		tag: "cloudcred-google_bob_foo_bar",
+		err: names.InvalidTagError("cloudcred-google_bob_foo_bar", names.CloudCredentialTagKind),
 	}, {
 		tag: "foo",
 		err: names.InvalidTagError("foo", ""),
@@ -161,3 +178,44 @@ func (s *cloudCredentialSuite) TestZeroString(c *gc.C) {
 func (s *cloudCredentialSuite) TestZeroId(c *gc.C) {
 	c.Assert(names.CloudCredentialTag{}.Id(), gc.Equals, "")
 }
+
+func (s *cloudCredentialSuite) TestRoundTripPlus(c *gc.C) {
+	for _, id := range []string{
+		"google/bob+bob@remote/foo+bar",
+		"a_b/c+d@e.f/g_h+i",
+		"aws/bob/f",
+	} {
+		tag := names.NewCloudCredentialTag(id)
+		parsed, err := names.ParseCloudCredentialTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func FuzzCloudCredentialTagRoundTrip(f *stdtesting.F) {
+	f.Add("aws", "bob", "foo")
+	f.Add("manual_cloud", "bob+bob@remote", "foo_bar")
+	f.Add("google", "b.o-b@x_y", "a+b@c.d")
+	f.Fuzz(func(t *stdtesting.T, cloud, owner, name string) {
+		id := cloud + "/" + owner + "/" + name
+		if !names.IsValidCloudCredential(id) {
+			return
+		}
+		tag := names.NewCloudCredentialTag(id)
+		parsed, err := names.ParseCloudCredentialTag(tag.String())
+		if err != nil {
+			t.Fatalf("parsing %q for %q: %v", tag.String(), id, err)
+		}
+		if parsed != tag {
+			t.Fatalf("%q round-tripped to %q", id, parsed.Id())
+		}
+	})
+}
+
+func (s *cloudCredentialSuite) TestParseCloudCredentialTagBadEscape(c *gc.C) {
+	_, err := names.ParseCloudCredentialTag("cloudcred-google_bob%zz_foo")
+	c.Assert(err, gc.ErrorMatches, `"cloudcred-google_bob%zz_foo" is not a valid cloudcred tag`)
+	c.Assert(errors.Cause(err), gc.DeepEquals, names.InvalidTagError("cloudcred-google_bob%zz_foo", names.CloudCredentialTagKind))
+	var escapeErr url.EscapeError
+	c.Assert(errors.As(err, &escapeErr), gc.Equals, true)
+}
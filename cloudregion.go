@@ -0,0 +1,154 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CloudRegionTagKind is used as the prefix for the string representation
+// of cloud region tags.
+const CloudRegionTagKind = "cloudregion"
+
+// Cloud region ids have the format "cloud/region", for example
+// "aws/us-east-1". Cloud region tags have the format
+// "cloudregion-cloud_region", with any "_" in the cloud or region
+// name escaped as "%5f", as for cloud credential tags.
+var (
+	cloudRegionNameSnippet = "[a-zA-Z0-9][a-zA-Z0-9._-]*"
+	validCloudRegionName   = regexp.MustCompile("^" + cloudRegionNameSnippet + "$")
+	validCloudRegion       = regexp.MustCompile("^(" + cloudSnippet + ")/(" + cloudRegionNameSnippet + ")$")
+)
+
+// IsValidCloudRegion returns whether id is a valid cloud region id.
+func IsValidCloudRegion(id string) bool {
+	return validCloudRegion.MatchString(id)
+}
+
+// IsValidCloudRegionName returns whether name is a valid region name,
+// excluding the cloud qualifier.
+func IsValidCloudRegionName(name string) bool {
+	return validCloudRegionName.MatchString(name)
+}
+
+// CloudRegionTag represents a region of a cloud.
+type CloudRegionTag struct {
+	cloud  CloudTag
+	region string
+}
+
+// Kind implements Tag.
+func (t CloudRegionTag) Kind() string { return CloudRegionTagKind }
+
+// Id implements Tag.Id. It returns the empty string if t is zero.
+func (t CloudRegionTag) Id() string {
+	if t.region == "" {
+		return ""
+	}
+	return t.cloud.Id() + "/" + t.region
+}
+
+// String implements Tag.
+func (t CloudRegionTag) String() string {
+	return t.Kind() + "-" + quoteCredentialSeparator(t.cloud.Id()) + "_" + quoteCredentialSeparator(t.region)
+}
+
+// Cloud returns the tag of the cloud the region belongs to.
+func (t CloudRegionTag) Cloud() CloudTag { return t.cloud }
+
+// Region returns the region name, excluding the cloud.
+func (t CloudRegionTag) Region() string { return t.region }
+
+// NewCloudRegionTag returns the tag for the cloud region with the given
+// id. It will panic if the given id is not valid.
+func NewCloudRegionTag(id string) CloudRegionTag {
+	parts := validCloudRegion.FindStringSubmatch(id)
+	if len(parts) != 3 {
+		panic(fmt.Sprintf("%q is not a valid cloud region ID", id))
+	}
+	return CloudRegionTag{cloud: NewCloudTag(parts[1]), region: parts[2]}
+}
+
+// ParseCloudRegion parses a "cloud/region" reference.
+func ParseCloudRegion(s string) (CloudRegionTag, error) {
+	if !IsValidCloudRegion(s) {
+		return CloudRegionTag{}, fmt.Errorf("%q is not a valid cloud region", s)
+	}
+	return NewCloudRegionTag(s), nil
+}
+
+// ParseCloudRegionTag parses a cloud region tag string.
+func ParseCloudRegionTag(s string) (CloudRegionTag, error) {
+	return ParseAs[CloudRegionTag](s)
+}
+
+func cloudRegionTagSuffixToId(s string) string {
+	parts, err := decodeTagParts(s, 2)
+	if err != nil {
+		return ""
+	}
+	return strings.Join(parts, "/")
+}
+
+// CloudRegionCredential is a cloud credential bound to a region of
+// the credential's cloud.
+//
+// The string form is "cloud/region/owner/name", for example
+// "aws/us-east-1/bob/default".
+type CloudRegionCredential struct {
+	Region     CloudRegionTag
+	Credential CloudCredentialTag
+}
+
+// NewCloudRegionCredential binds the credential to the given region.
+// It returns an error if the region and credential are for different
+// clouds.
+func NewCloudRegionCredential(region CloudRegionTag, credential CloudCredentialTag) (CloudRegionCredential, error) {
+	if region.Cloud() != credential.Cloud() {
+		return CloudRegionCredential{}, fmt.Errorf(
+			"credential %q is not for cloud %q", credential.Id(), region.Cloud().Id())
+	}
+	return CloudRegionCredential{Region: region, Credential: credential}, nil
+}
+
+// ParseCloudRegionCredential parses the string form of a cloud region
+// credential.
+func ParseCloudRegionCredential(s string) (CloudRegionCredential, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 4 {
+		return CloudRegionCredential{}, fmt.Errorf("%q is not a valid cloud region credential", s)
+	}
+	regionId := parts[0] + "/" + parts[1]
+	credentialId := parts[0] + "/" + parts[2] + "/" + parts[3]
+	if !IsValidCloudRegion(regionId) || !IsValidCloudCredential(credentialId) {
+		return CloudRegionCredential{}, fmt.Errorf("%q is not a valid cloud region credential", s)
+	}
+	return CloudRegionCredential{
+		Region:     NewCloudRegionTag(regionId),
+		Credential: NewCloudCredentialTag(credentialId),
+	}, nil
+}
+
+// String returns the canonical string form of the cloud region
+// credential.
+func (c CloudRegionCredential) String() string {
+	return c.Region.Id() + "/" + c.Credential.Owner().Id() + "/" + c.Credential.Name()
+}
+
+// TryNewCloudRegionTag returns the tag for the given id, or an error if id is
+// not a valid cloud region ID.
+func TryNewCloudRegionTag(id string) (CloudRegionTag, error) {
+	if !IsValidCloudRegion(id) {
+		return CloudRegionTag{}, fmt.Errorf("%q is not a valid cloud region ID", id)
+	}
+	return NewCloudRegionTag(id), nil
+}
+
+// MustNewCloudRegionTag returns the tag for the given id.
+// It will panic if id is not a valid cloud region ID.
+func MustNewCloudRegionTag(id string) CloudRegionTag {
+	return mustTag(TryNewCloudRegionTag(id))
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type cloudRegionSuite struct{}
+
+var _ = gc.Suite(&cloudRegionSuite{})
+
+var cloudRegionIdTests = []struct {
+	id     string
+	valid  bool
+	cloud  string
+	region string
+	tag    string
+}{
+	{id: "aws/us-east-1", valid: true, cloud: "aws", region: "us-east-1", tag: "cloudregion-aws_us-east-1"},
+	{id: "openstack/RegionOne", valid: true, cloud: "openstack", region: "RegionOne", tag: "cloudregion-openstack_RegionOne"},
+	{id: "my_cloud/region_1", valid: true, cloud: "my_cloud", region: "region_1", tag: "cloudregion-my%5fcloud_region%5f1"},
+	{id: "aws", valid: false},
+	{id: "aws/", valid: false},
+	{id: "/us-east-1", valid: false},
+	{id: "aws/us-east-1/a", valid: false},
+	{id: "aws/-us-east-1", valid: false},
+	{id: "aws/us+east", valid: false},
+}
+
+func (s *cloudRegionSuite) TestCloudRegionIds(c *gc.C) {
+	for i, test := range cloudRegionIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidCloudRegion(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expect := regexp.QuoteMeta(fmt.Sprintf("%q is not a valid cloud region ID", test.id))
+			c.Check(func() { names.NewCloudRegionTag(test.id) }, gc.PanicMatches, expect)
+			_, err := names.ParseCloudRegion(test.id)
+			c.Check(err, gc.ErrorMatches, regexp.QuoteMeta(fmt.Sprintf("%q is not a valid cloud region", test.id)))
+			continue
+		}
+		tag, err := names.ParseCloudRegion(test.id)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(tag, gc.Equals, names.NewCloudRegionTag(test.id))
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Cloud(), gc.Equals, names.NewCloudTag(test.cloud))
+		c.Check(tag.Region(), gc.Equals, test.region)
+		c.Check(names.NewCloudTag(test.cloud).Region(test.region), gc.Equals, tag)
+
+		parsed, err := names.ParseCloudRegionTag(test.tag)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *cloudRegionSuite) TestCloudTagRegionInvalid(c *gc.C) {
+	c.Assert(func() { names.NewCloudTag("aws").Region("") }, gc.PanicMatches, `"" is not a valid cloud region name`)
+}
+
+func (s *cloudRegionSuite) TestCloudRegionCredential(c *gc.C) {
+	credential := names.NewCloudCredentialTag("aws/bob@external/default")
+	bound, err := credential.InRegion("us-east-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(bound.Region, gc.Equals, names.NewCloudRegionTag("aws/us-east-1"))
+	c.Assert(bound.Credential, gc.Equals, credential)
+	c.Assert(bound.String(), gc.Equals, "aws/us-east-1/bob@external/default")
+
+	parsed, err := names.ParseCloudRegionCredential(bound.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(parsed, gc.Equals, bound)
+
+	_, err = credential.InRegion("us east")
+	c.Assert(err, gc.ErrorMatches, `"us east" is not a valid cloud region name`)
+
+	_, err = names.NewCloudRegionCredential(names.NewCloudRegionTag("gce/us-east1"), credential)
+	c.Assert(err, gc.ErrorMatches, `credential "aws/bob@external/default" is not for cloud "gce"`)
+}
+
+func (s *cloudRegionSuite) TestParseCloudRegionCredentialInvalid(c *gc.C) {
+	for _, str := range []string{"", "aws/us-east-1", "aws/us-east-1/bob", "aws/us-east-1/bob/default/x", "aws/us-east-1/b/default"} {
+		_, err := names.ParseCloudRegionCredential(str)
+		c.Check(err, gc.ErrorMatches, fmt.Sprintf("%q is not a valid cloud region credential", str))
+	}
+}
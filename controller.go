@@ -4,6 +4,7 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
 )
 
@@ -16,7 +17,9 @@ type ControllerTag struct {
 }
 
 // Lowercase letters, digits and (non-leading) hyphens.
-var validControllerName = regexp.MustCompile(`^[a-z0-9]+[a-z0-9-]*$`)
+const controllerNameSnippet = `[a-z0-9]+[a-z0-9-]*`
+
+var validControllerName = regexp.MustCompile("^" + controllerNameSnippet + "$")
 
 // NewControllerTag returns the tag of an controller with the given controller UUID.
 func NewControllerTag(uuid string) ControllerTag {
@@ -25,15 +28,7 @@ func NewControllerTag(uuid string) ControllerTag {
 
 // ParseControllerTag parses an environ tag string.
 func ParseControllerTag(controllerTag string) (ControllerTag, error) {
-	tag, err := ParseTag(controllerTag)
-	if err != nil {
-		return ControllerTag{}, err
-	}
-	et, ok := tag.(ControllerTag)
-	if !ok {
-		return ControllerTag{}, invalidTagError(controllerTag, ControllerTagKind)
-	}
-	return et, nil
+	return ParseAs[ControllerTag](controllerTag)
 }
 
 // String implements Tag.
@@ -47,10 +42,25 @@ func (t ControllerTag) Id() string { return t.uuid }
 
 // IsValidController returns whether id is a valid controller UUID.
 func IsValidController(id string) bool {
-	return validUUID.MatchString(id)
+	return isValidUUID(id)
 }
 
 // IsValidControllerName returns whether name is a valid string safe for a controller name.
 func IsValidControllerName(name string) bool {
 	return validControllerName.MatchString(name)
 }
+
+// TryNewControllerTag returns the tag of a controller with the given UUID,
+// or an error if uuid is not valid.
+func TryNewControllerTag(uuid string) (ControllerTag, error) {
+	if !IsValidController(uuid) {
+		return ControllerTag{}, fmt.Errorf("%q is not a valid controller UUID", uuid)
+	}
+	return ControllerTag{uuid: uuid}, nil
+}
+
+// MustNewControllerTag returns the tag of a controller with the given UUID.
+// It will panic if uuid is not valid.
+func MustNewControllerTag(uuid string) ControllerTag {
+	return mustTag(TryNewControllerTag(uuid))
+}
@@ -30,15 +30,7 @@ func NewControllerAgentTag(id string) ControllerAgentTag {
 
 // ParseControllerAgentTag parses a controller agent tag string.
 func ParseControllerAgentTag(controllerAgentTag string) (ControllerAgentTag, error) {
-	tag, err := ParseTag(controllerAgentTag)
-	if err != nil {
-		return ControllerAgentTag{}, err
-	}
-	et, ok := tag.(ControllerAgentTag)
-	if !ok {
-		return ControllerAgentTag{}, invalidTagError(controllerAgentTag, ControllerAgentTagKind)
-	}
-	return et, nil
+	return ParseAs[ControllerAgentTag](controllerAgentTag)
 }
 
 // Number returns the controller agent number.
@@ -60,3 +52,19 @@ func (t ControllerAgentTag) Id() string { return t.id }
 func IsValidControllerAgent(id string) bool {
 	return validControllerAgentId.MatchString(id)
 }
+
+// TryNewControllerAgentTag returns the tag for the given id, or an error if id is
+// not a valid controller agent id.
+func TryNewControllerAgentTag(id string) (ControllerAgentTag, error) {
+	if !IsValidControllerAgent(id) {
+		return ControllerAgentTag{}, fmt.Errorf("%q is not a valid controller agent id", id)
+	}
+	// NewControllerAgentTag panics on ids too large for an int.
+	return ControllerAgentTag{id: id}, nil
+}
+
+// MustNewControllerAgentTag returns the tag for the given id.
+// It will panic if id is not a valid controller agent id.
+func MustNewControllerAgentTag(id string) ControllerAgentTag {
+	return mustTag(TryNewControllerAgentTag(id))
+}
@@ -18,6 +18,17 @@ func (s *ControllerAgentSuite) TestControllerAgentTag(c *gc.C) {
 	c.Assert(names.NewControllerAgentTag("123").String(), gc.Equals, "controller-123")
 }
 
+func (s *ControllerAgentSuite) TestLargeId(c *gc.C) {
+	const id = "10000000000000000000"
+	tag, err := names.TryNewControllerAgentTag(id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tag.Id(), gc.Equals, id)
+
+	parsed, err := names.ParseTag("controller-" + id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(parsed, gc.Equals, tag)
+}
+
 func (s *ControllerAgentSuite) TestIdFormats(c *gc.C) {
 	c.Assert(names.IsValidControllerAgent("123"), jc.IsTrue)
 	c.Assert(names.IsValidControllerAgent("-123"), jc.IsFalse)
@@ -0,0 +1,84 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EndpointTagKind is used as the prefix for the string representation
+// of endpoint tags.
+const EndpointTagKind = "endpoint"
+
+// Endpoint ids have the format "application:endpoint", as used in
+// relation keys. Endpoint tags have the format "endpoint-application.endpoint".
+// A peer relation key is a single endpoint, so shares its grammar.
+var validEndpoint = validPeerRelation
+
+// IsValidEndpoint returns whether id is a valid application endpoint id.
+func IsValidEndpoint(id string) bool {
+	return validEndpoint.MatchString(id)
+}
+
+// EndpointTag represents an endpoint of an application.
+type EndpointTag struct {
+	application ApplicationTag
+	name        string
+}
+
+func (t EndpointTag) Kind() string { return EndpointTagKind }
+
+func (t EndpointTag) String() string {
+	return t.Kind() + "-" + strings.Replace(t.Id(), ":", ".", 1)
+}
+
+// Id implements Tag.Id. It returns the empty string if t is zero.
+func (t EndpointTag) Id() string {
+	if t.name == "" {
+		return ""
+	}
+	return t.application.Id() + ":" + t.name
+}
+
+// Application returns the tag of the application the endpoint
+// belongs to.
+func (t EndpointTag) Application() ApplicationTag { return t.application }
+
+// Name returns the endpoint name, excluding the application.
+func (t EndpointTag) Name() string { return t.name }
+
+// NewEndpointTag returns the tag for the endpoint with the given id.
+// It will panic if the given id is not valid.
+func NewEndpointTag(id string) EndpointTag {
+	if !IsValidEndpoint(id) {
+		panic(fmt.Sprintf("%q is not a valid endpoint id", id))
+	}
+	i := strings.Index(id, ":")
+	return EndpointTag{application: NewApplicationTag(id[:i]), name: id[i+1:]}
+}
+
+// ParseEndpointTag parses an endpoint tag string.
+func ParseEndpointTag(endpointTag string) (EndpointTag, error) {
+	return ParseAs[EndpointTag](endpointTag)
+}
+
+func endpointTagSuffixToId(s string) string {
+	return strings.Replace(s, ".", ":", 1)
+}
+
+// TryNewEndpointTag returns the tag for the given id, or an error if id is
+// not a valid endpoint id.
+func TryNewEndpointTag(id string) (EndpointTag, error) {
+	if !IsValidEndpoint(id) {
+		return EndpointTag{}, fmt.Errorf("%q is not a valid endpoint id", id)
+	}
+	return NewEndpointTag(id), nil
+}
+
+// MustNewEndpointTag returns the tag for the given id.
+// It will panic if id is not a valid endpoint id.
+func MustNewEndpointTag(id string) EndpointTag {
+	return mustTag(TryNewEndpointTag(id))
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type endpointSuite struct{}
+
+var _ = gc.Suite(&endpointSuite{})
+
+var endpointIdTests = []struct {
+	id          string
+	valid       bool
+	application string
+	name        string
+}{
+	{id: "mysql:db", valid: true, application: "mysql", name: "db"},
+	{id: "mysql-router:db-router", valid: true, application: "mysql-router", name: "db-router"},
+	{id: "wordpress:cache_1", valid: true, application: "wordpress", name: "cache_1"},
+	{id: "mysql", valid: false},
+	{id: "mysql:", valid: false},
+	{id: ":db", valid: false},
+	{id: "mysql:db:extra", valid: false},
+	{id: "mysql:db wordpress:db", valid: false},
+	{id: "mysql:Db", valid: false},
+}
+
+func (s *endpointSuite) TestEndpointIds(c *gc.C) {
+	for i, test := range endpointIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidEndpoint(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expect := fmt.Sprintf("%q is not a valid endpoint id", test.id)
+			c.Check(func() { names.NewEndpointTag(test.id) }, gc.PanicMatches, expect)
+			continue
+		}
+		tag := names.NewEndpointTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.Application(), gc.Equals, names.NewApplicationTag(test.application))
+		c.Check(tag.Name(), gc.Equals, test.name)
+
+		parsed, err := names.ParseEndpointTag(tag.String())
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *endpointSuite) TestEndpointTagInSet(c *gc.C) {
+	set, err := names.NewSetFromStrings("endpoint-mysql.db", "endpoint-mysql-router.db-router", "application-mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(set.Contains(names.NewEndpointTag("mysql:db")), jc.IsTrue)
+	c.Assert(set.SortedValues(), jc.DeepEquals, []names.Tag{
+		names.NewApplicationTag("mysql"),
+		names.NewEndpointTag("mysql-router:db-router"),
+		names.NewEndpointTag("mysql:db"),
+	})
+}
+
+var parseEndpointTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{
+	{tag: "", err: names.InvalidTagError("", "")},
+	{tag: "endpoint-mysql.db", expected: names.NewEndpointTag("mysql:db")},
+	{tag: "endpoint-mysql", err: names.InvalidTagError("endpoint-mysql", names.EndpointTagKind)},
+	{tag: "relation-mysql.db", err: names.InvalidTagError("relation-mysql.db", names.EndpointTagKind)},
+}
+
+func (s *endpointSuite) TestParseEndpointTag(c *gc.C) {
+	for i, t := range parseEndpointTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseEndpointTag(t.tag)
+		if t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
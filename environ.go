@@ -3,6 +3,8 @@
 
 package names
 
+import "fmt"
+
 // EnvironTagKind is DEPRECATED: model tags are used instead.
 const EnvironTagKind = "environment"
 
@@ -17,15 +19,7 @@ func NewEnvironTag(uuid string) EnvironTag {
 
 // ParseEnvironTag parses an environ tag string.
 func ParseEnvironTag(environTag string) (EnvironTag, error) {
-	tag, err := ParseTag(environTag)
-	if err != nil {
-		return EnvironTag{}, err
-	}
-	et, ok := tag.(EnvironTag)
-	if !ok {
-		return EnvironTag{}, invalidTagError(environTag, EnvironTagKind)
-	}
-	return et, nil
+	return ParseAs[EnvironTag](environTag)
 }
 
 func (t EnvironTag) String() string { return t.Kind() + "-" + t.Id() }
@@ -34,5 +28,26 @@ func (t EnvironTag) Id() string     { return t.uuid }
 
 // IsValidEnvironment returns whether id is a valid environment UUID.
 func IsValidEnvironment(id string) bool {
-	return validUUID.MatchString(id)
+	return isValidUUID(id)
+}
+
+// TryNewEnvironTag returns the tag of an environment with the given UUID,
+// or an error if uuid is not valid.
+func TryNewEnvironTag(uuid string) (EnvironTag, error) {
+	if !IsValidEnvironment(uuid) {
+		return EnvironTag{}, fmt.Errorf("%q is not a valid environment UUID", uuid)
+	}
+	return EnvironTag{uuid: uuid}, nil
+}
+
+// MustNewEnvironTag returns the tag of an environment with the given UUID.
+// It will panic if uuid is not valid.
+func MustNewEnvironTag(uuid string) EnvironTag {
+	return mustTag(TryNewEnvironTag(uuid))
+}
+
+// ModelTag returns the model tag with the same UUID as t, as environments
+// are now called models.
+func (t EnvironTag) ModelTag() ModelTag {
+	return ModelTag{uuid: t.uuid}
 }
@@ -35,15 +35,7 @@ func NewFilesystemTag(id string) FilesystemTag {
 
 // ParseFilesystemTag parses a filesystem tag string.
 func ParseFilesystemTag(filesystemTag string) (FilesystemTag, error) {
-	tag, err := ParseTag(filesystemTag)
-	if err != nil {
-		return FilesystemTag{}, err
-	}
-	fstag, ok := tag.(FilesystemTag)
-	if !ok {
-		return FilesystemTag{}, invalidTagError(filesystemTag, FilesystemTagKind)
-	}
-	return fstag, nil
+	return ParseAs[FilesystemTag](filesystemTag)
 }
 
 // IsValidFilesystem returns whether id is a valid filesystem id.
@@ -106,3 +98,18 @@ func filesystemOrVolumeTagSuffixToId(s string) string {
 	}
 	return s
 }
+
+// TryNewFilesystemTag returns the tag for the given id, or an error if id is
+// not a valid filesystem id.
+func TryNewFilesystemTag(id string) (FilesystemTag, error) {
+	if !IsValidFilesystem(id) {
+		return FilesystemTag{}, fmt.Errorf("%q is not a valid filesystem id", id)
+	}
+	return NewFilesystemTag(id), nil
+}
+
+// MustNewFilesystemTag returns the tag for the given id.
+// It will panic if id is not a valid filesystem id.
+func MustNewFilesystemTag(id string) FilesystemTag {
+	return mustTag(TryNewFilesystemTag(id))
+}
@@ -0,0 +1,509 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"reflect"
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+// The fuzz targets in this file check the invariants every kind must
+// hold, for any id:
+//
+//   - IsValid*(id) is true exactly when TryNew*(id) succeeds and, for
+//     constructors that validate, when New*(id) does not panic;
+//   - for a valid id, ParseTag(New*(id).String()) equals the tag;
+//   - for a valid id, New*(tag.Id()) equals the tag.
+//
+// FuzzParseTag checks that any tag ParseTag accepts reparses from its
+// String form. Inputs that parse but are not returned unchanged by
+// String are listed in knownNonCanonicalTags below.
+//
+// Run a single target with, for example:
+//
+//	go test -run XXX -fuzz FuzzUnitTag
+
+// fuzzKind describes how to check one kind of tag.
+type fuzzKind struct {
+	// valid is the kind's IsValid* function.
+	valid func(string) bool
+	// tryNew is the kind's TryNew* function.
+	tryNew func(string) (names.Tag, error)
+	// newTag is the kind's New* function.
+	newTag func(string) names.Tag
+	// newValidates holds whether newTag panics exactly on invalid ids.
+	// Some older constructors accept any id, or only reject some
+	// invalid ids.
+	newValidates bool
+}
+
+// isValidPayload matches the validation done by ParseTag and
+// TryNewPayloadTag, which unlike IsValidPayload also accept UUIDs.
+func isValidPayload(id string) bool {
+	return names.IsValidPayload(id) || names.IsValidIPAddress(id)
+}
+
+func checkKindInvariants(t *stdtesting.T, k fuzzKind, id string) {
+	valid := k.valid(id)
+	tag, err := k.tryNew(id)
+	if valid != (err == nil) {
+		t.Fatalf("IsValid(%q) is %v, but TryNew returned error %v", id, valid, err)
+	}
+	if k.newValidates {
+		if panicked := newPanics(k.newTag, id); panicked == valid {
+			t.Fatalf("IsValid(%q) is %v, but New panicking is %v", id, valid, panicked)
+		}
+	}
+	if !valid {
+		return
+	}
+	parsed, err := names.ParseTag(tag.String())
+	if err != nil {
+		t.Fatalf("cannot parse %q, the tag for %q: %v", tag.String(), id, err)
+	}
+	if parsed != tag {
+		t.Fatalf("%q parsed as %#v, expected %#v", tag.String(), parsed, tag)
+	}
+	if again := k.newTag(tag.Id()); again != tag {
+		t.Fatalf("New(%q) returned %#v, expected %#v", tag.Id(), again, tag)
+	}
+}
+
+func newPanics(newTag func(string) names.Tag, id string) (panicked bool) {
+	defer func() {
+		if recover() != nil {
+			panicked = true
+		}
+	}()
+	newTag(id)
+	return false
+}
+
+func fuzzKindTag(f *stdtesting.F, k fuzzKind, kind reflect.Type) {
+	for _, seed := range idSeeds(kind) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *stdtesting.T, id string) {
+		checkKindInvariants(t, k, id)
+	})
+}
+
+func FuzzActionTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidAction,
+		tryNew:       tryNew(names.TryNewActionTag),
+		newTag:       mustNew(names.NewActionTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.ActionTag{}))
+}
+
+func FuzzApplicationTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidApplication,
+		tryNew:       tryNew(names.TryNewApplicationTag),
+		newTag:       mustNew(names.NewApplicationTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.ApplicationTag{}))
+}
+
+func FuzzApplicationOfferTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidApplicationOffer,
+		tryNew:       tryNew(names.TryNewApplicationOfferTag),
+		newTag:       mustNew(names.NewApplicationOfferTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.ApplicationOfferTag{}))
+}
+
+func FuzzCAASModelTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidCAASModel,
+		tryNew:       tryNew(names.TryNewCAASModelTag),
+		newTag:       mustNew(names.NewCAASModelTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.CAASModelTag{}))
+}
+
+func FuzzCharmTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidCharm,
+		tryNew:       tryNew(names.TryNewCharmTag),
+		newTag:       mustNew(names.NewCharmTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.CharmTag{}))
+}
+
+func FuzzCloudTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidCloud,
+		tryNew:       tryNew(names.TryNewCloudTag),
+		newTag:       mustNew(names.NewCloudTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.CloudTag{}))
+}
+
+func FuzzCloudCredentialTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidCloudCredential,
+		tryNew:       tryNew(names.TryNewCloudCredentialTag),
+		newTag:       mustNew(names.NewCloudCredentialTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.CloudCredentialTag{}))
+}
+
+func FuzzCloudRegionTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidCloudRegion,
+		tryNew:       tryNew(names.TryNewCloudRegionTag),
+		newTag:       mustNew(names.NewCloudRegionTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.CloudRegionTag{}))
+}
+
+func FuzzControllerTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidController,
+		tryNew:       tryNew(names.TryNewControllerTag),
+		newTag:       mustNew(names.NewControllerTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.ControllerTag{}))
+}
+
+// FuzzControllerAgentTag checks MustNewControllerAgentTag rather than
+// NewControllerAgentTag, which accepts ids such as "01" and panics on
+// ones too large for an int.
+func FuzzControllerAgentTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidControllerAgent,
+		tryNew:       tryNew(names.TryNewControllerAgentTag),
+		newTag:       mustNew(names.MustNewControllerAgentTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.ControllerAgentTag{}))
+}
+
+func FuzzEndpointTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidEndpoint,
+		tryNew:       tryNew(names.TryNewEndpointTag),
+		newTag:       mustNew(names.NewEndpointTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.EndpointTag{}))
+}
+
+func FuzzEnvironTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidEnvironment,
+		tryNew:       tryNew(names.TryNewEnvironTag),
+		newTag:       mustNew(names.NewEnvironTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.EnvironTag{}))
+}
+
+func FuzzFilesystemTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidFilesystem,
+		tryNew:       tryNew(names.TryNewFilesystemTag),
+		newTag:       mustNew(names.NewFilesystemTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.FilesystemTag{}))
+}
+
+func FuzzIPAddressTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidIPAddress,
+		tryNew:       tryNew(names.TryNewIPAddressTag),
+		newTag:       mustNew(names.NewIPAddressTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.IPAddressTag{}))
+}
+
+func FuzzLinkLayerDeviceTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidLinkLayerDevice,
+		tryNew:       tryNew(names.TryNewLinkLayerDeviceTag),
+		newTag:       mustNew(names.NewLinkLayerDeviceTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.LinkLayerDeviceTag{}))
+}
+
+func FuzzMachineTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidMachine,
+		tryNew:       tryNew(names.TryNewMachineTag),
+		newTag:       mustNew(names.NewMachineTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.MachineTag{}))
+}
+
+func FuzzModelTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidModel,
+		tryNew:       tryNew(names.TryNewModelTag),
+		newTag:       mustNew(names.NewModelTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.ModelTag{}))
+}
+
+func FuzzOperationTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidOperation,
+		tryNew:       tryNew(names.TryNewOperationTag),
+		newTag:       mustNew(names.NewOperationTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.OperationTag{}))
+}
+
+func FuzzPayloadTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        isValidPayload,
+		tryNew:       tryNew(names.TryNewPayloadTag),
+		newTag:       mustNew(names.NewPayloadTag),
+		newValidates: false,
+	}, reflect.TypeOf(names.PayloadTag{}))
+}
+
+func FuzzRelationTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidRelation,
+		tryNew:       tryNew(names.TryNewRelationTag),
+		newTag:       mustNew(names.NewRelationTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.RelationTag{}))
+}
+
+func FuzzResourceTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidResource,
+		tryNew:       tryNew(names.TryNewResourceTag),
+		newTag:       mustNew(names.NewResourceTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.ResourceTag{}))
+}
+
+func FuzzSecretTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidSecret,
+		tryNew:       tryNew(names.TryNewSecretTag),
+		newTag:       mustNew(names.NewSecretTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.SecretTag{}))
+}
+
+func FuzzSpaceTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidSpace,
+		tryNew:       tryNew(names.TryNewSpaceTag),
+		newTag:       mustNew(names.NewSpaceTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.SpaceTag{}))
+}
+
+func FuzzStorageTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidStorage,
+		tryNew:       tryNew(names.TryNewStorageTag),
+		newTag:       mustNew(names.NewStorageTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.StorageTag{}))
+}
+
+func FuzzSubnetTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidSubnet,
+		tryNew:       tryNew(names.TryNewSubnetTag),
+		newTag:       mustNew(names.NewSubnetTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.SubnetTag{}))
+}
+
+func FuzzUnitTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidUnit,
+		tryNew:       tryNew(names.TryNewUnitTag),
+		newTag:       mustNew(names.NewUnitTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.UnitTag{}))
+}
+
+func FuzzUserTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidUser,
+		tryNew:       tryNew(names.TryNewUserTag),
+		newTag:       mustNew(names.NewUserTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.UserTag{}))
+}
+
+func FuzzVolumeTag(f *stdtesting.F) {
+	fuzzKindTag(f, fuzzKind{
+		valid:        names.IsValidVolume,
+		tryNew:       tryNew(names.TryNewVolumeTag),
+		newTag:       mustNew(names.NewVolumeTag),
+		newValidates: true,
+	}, reflect.TypeOf(names.VolumeTag{}))
+}
+
+func FuzzParseTag(f *stdtesting.F) {
+	for _, seed := range tagSeeds() {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *stdtesting.T, s string) {
+		tag, err := names.ParseTag(s)
+		if err != nil {
+			return
+		}
+		parsed, err := names.ParseTag(tag.String())
+		if err != nil {
+			t.Fatalf("%q parsed, but its string form %q did not: %v", s, tag.String(), err)
+		}
+		if parsed != tag {
+			t.Fatalf("%q parsed as %#v, but %q parsed as %#v", s, tag, tag.String(), parsed)
+		}
+	})
+}
+
+// tagSeeds returns the tag strings used by the example tables, as a
+// seed corpus for FuzzParseTag.
+func tagSeeds() []string {
+	var seeds []string
+	for _, t := range parseTagTests {
+		seeds = append(seeds, t.tag)
+	}
+	for _, t := range tagKindTests {
+		seeds = append(seeds, t.tag)
+	}
+	for _, tests := range [][]struct {
+		tag      string
+		expected names.Tag
+		err      error
+	}{
+		parseActionTagTests, parseApplicationTagTests, parseApplicationOfferTagTests,
+		parseCAASModelTagTests, parseControllerAgentTagTests, parseEndpointTagTests,
+		parseEnvironTagTests, parseIPAddressTagTests, parseMachineTagTests,
+		parseOperationTagTests, parseRelationTagTests, parseResourceTagTests,
+		parseSecretTagTests, parseSpaceTagTests, parseSubnetTagTests, parseUnitTagTests,
+	} {
+		for _, t := range tests {
+			seeds = append(seeds, t.tag)
+		}
+	}
+	for _, t := range parseControllerTagTests {
+		seeds = append(seeds, t.tag)
+	}
+	for _, t := range parseModelTagTests {
+		seeds = append(seeds, t.tagString)
+	}
+	for _, t := range knownNonCanonicalTags {
+		seeds = append(seeds, t.tag)
+	}
+	for _, info := range names.Kinds() {
+		seeds = append(seeds, info.ExampleTags...)
+	}
+	return seeds
+}
+
+// idSeeds returns ids for the given kind of tag taken from the example
+// tables, both valid and invalid, as a seed corpus.
+func idSeeds(kind reflect.Type) []string {
+	var seeds []string
+	for _, info := range names.Kinds() {
+		if info.Type == kind {
+			seeds = append(seeds, info.ExampleIDs...)
+		}
+	}
+	for _, s := range tagSeeds() {
+		tag, err := names.ParseTag(s)
+		if err == nil && reflect.TypeOf(tag) == kind {
+			seeds = append(seeds, tag.Id())
+		}
+	}
+	switch kind {
+	case reflect.TypeOf(names.ApplicationTag{}):
+		for _, t := range applicationNameTests {
+			seeds = append(seeds, t.pattern)
+		}
+	case reflect.TypeOf(names.UnitTag{}):
+		for _, t := range unitNameTests {
+			seeds = append(seeds, t.pattern)
+		}
+	case reflect.TypeOf(names.MachineTag{}):
+		for _, t := range machineIdTests {
+			seeds = append(seeds, t.pattern)
+		}
+	case reflect.TypeOf(names.RelationTag{}):
+		for _, t := range relationNameTests {
+			seeds = append(seeds, t.pattern)
+		}
+	case reflect.TypeOf(names.ResourceTag{}):
+		for _, t := range resourceIdTests {
+			seeds = append(seeds, t.id)
+		}
+	case reflect.TypeOf(names.EndpointTag{}):
+		for _, t := range endpointIdTests {
+			seeds = append(seeds, t.id)
+		}
+	case reflect.TypeOf(names.SecretTag{}):
+		for _, t := range secretIdTests {
+			seeds = append(seeds, t.id)
+		}
+	case reflect.TypeOf(names.SpaceTag{}):
+		for _, t := range spaceNameTests {
+			seeds = append(seeds, t.pattern)
+		}
+	case reflect.TypeOf(names.LinkLayerDeviceTag{}):
+		for _, t := range linkLayerDeviceIdTests {
+			seeds = append(seeds, t.id)
+		}
+	case reflect.TypeOf(names.CloudRegionTag{}):
+		for _, t := range cloudRegionIdTests {
+			seeds = append(seeds, t.id)
+		}
+	case reflect.TypeOf(names.CharmTag{}):
+		for _, t := range charmURLTests {
+			seeds = append(seeds, t.url)
+		}
+	}
+	return seeds
+}
+
+// knownNonCanonicalTags lists tag strings that ParseTag accepts, but
+// whose String form differs from the input. They all reparse to the
+// same tag, which FuzzParseTag checks.
+var knownNonCanonicalTags = []struct {
+	tag       string
+	canonical string
+	reason    string
+}{
+	{
+		tag:       "cloudcred-google_bob%2bbob%40remote_foo%5Fbar",
+		canonical: "cloudcred-google_bob+bob@remote_foo%5fbar",
+		reason:    "credential tags accept legacy percent-escapes and upper case %5F",
+	}, {
+		tag:       "cloudregion-aws_us%5Feast-1",
+		canonical: "cloudregion-aws_us%5feast-1",
+		reason:    "region tags accept upper case %5F",
+	}, {
+		tag:       "user-bob@local",
+		canonical: "user-bob",
+		reason:    "the local user domain is dropped",
+	},
+}
+
+type fuzzSuite struct{}
+
+var _ = gc.Suite(&fuzzSuite{})
+
+func (s *fuzzSuite) TestKnownNonCanonicalTags(c *gc.C) {
+	for i, t := range knownNonCanonicalTags {
+		c.Logf("test %d: %q (%s)", i, t.tag, t.reason)
+		tag, err := names.ParseTag(t.tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(tag.String(), gc.Equals, t.canonical)
+		c.Check(tag.String(), gc.Not(gc.Equals), t.tag)
+	}
+}
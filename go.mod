@@ -1,6 +1,6 @@
 module github.com/juju/names/v6
 
-go 1.21
+go 1.23
 
 require (
 	github.com/juju/errors v1.0.0
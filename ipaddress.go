@@ -4,6 +4,8 @@
 package names
 
 import (
+	"fmt"
+
 	"github.com/juju/utils/v3"
 )
 
@@ -34,13 +36,20 @@ func NewIPAddressTag(id string) IPAddressTag {
 
 // ParseIPAddressTag parses an IP address tag string.
 func ParseIPAddressTag(ipAddressTag string) (IPAddressTag, error) {
-	tag, err := ParseTag(ipAddressTag)
-	if err != nil {
-		return IPAddressTag{}, err
-	}
-	ipat, ok := tag.(IPAddressTag)
-	if !ok {
-		return IPAddressTag{}, invalidTagError(ipAddressTag, IPAddressTagKind)
+	return ParseAs[IPAddressTag](ipAddressTag)
+}
+
+// TryNewIPAddressTag returns the tag for the given id, or an error if id is
+// not a valid IP address id.
+func TryNewIPAddressTag(id string) (IPAddressTag, error) {
+	if !IsValidIPAddress(id) {
+		return IPAddressTag{}, fmt.Errorf("%q is not a valid IP address id", id)
 	}
-	return ipat, nil
+	return NewIPAddressTag(id), nil
+}
+
+// MustNewIPAddressTag returns the tag for the given id.
+// It will panic if id is not a valid IP address id.
+func MustNewIPAddressTag(id string) IPAddressTag {
+	return mustTag(TryNewIPAddressTag(id))
 }
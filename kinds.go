@@ -0,0 +1,168 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/juju/utils/v3"
+)
+
+// KindInfo describes one kind of tag understood by ParseTag.
+type KindInfo struct {
+	// Kind is the tag prefix, as returned by Tag.Kind. Controller and
+	// controller agent tags share the "controller" prefix.
+	Kind string
+
+	// Label is a human-readable name for the kind.
+	Label string
+
+	// Type is the Go type of tags of this kind.
+	Type reflect.Type
+
+	// IDPattern is an unanchored regular expression, composed from the
+	// *Snippet constants, describing the ids of this kind. It is a single
+	// group, so "^" + IDPattern + "$" matches whole ids. Some kinds apply
+	// further checks on top of it, such as length limits.
+	IDPattern string
+
+	// TagPattern is like IDPattern, but describes the part of the tag
+	// string that follows the kind and "-".
+	TagPattern string
+
+	// ExampleIDs holds valid ids of this kind.
+	ExampleIDs []string
+
+	// ExampleTags holds the tag strings for ExampleIDs, in the same order.
+	ExampleTags []string
+
+	// Deprecated reports whether the kind is only kept for compatibility.
+	Deprecated bool
+
+	// UUID reports whether ids of this kind are always UUIDs. Spaces and
+	// subnets still accept legacy ids, so are not reported as UUID-keyed.
+	UUID bool
+}
+
+type kindEntry struct {
+	info   KindInfo
+	newTag func(id string) Tag
+}
+
+func kind[T Tag](label, pattern string, newTag func(string) T, examples ...string) kindEntry {
+	var zero T
+	return kindEntry{
+		info: KindInfo{
+			Kind:       zero.Kind(),
+			Label:      label,
+			Type:       reflect.TypeOf(zero),
+			IDPattern:  "(?:" + pattern + ")",
+			TagPattern: "(?:" + pattern + ")",
+			ExampleIDs: examples,
+		},
+		newTag: func(id string) Tag { return newTag(id) },
+	}
+}
+
+// tag sets the tag pattern for kinds whose tags encode the id.
+func (e kindEntry) tag(pattern string) kindEntry {
+	e.info.TagPattern = "(?:" + pattern + ")"
+	return e
+}
+
+func (e kindEntry) deprecated() kindEntry {
+	e.info.Deprecated = true
+	return e
+}
+
+func (e kindEntry) uuid() kindEntry {
+	e.info.UUID = true
+	return e
+}
+
+const (
+	exampleUUID   = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	exampleUUIDv7 = "01959533-fa87-7fff-bfff-ffffffffffff"
+
+	storageAttachmentSnippet = "(?:(?:" + MachineSnippet + "|" + ApplicationSnippet + "/" + NumberSnippet + ")/)?" + NumberSnippet
+
+	// Tag encodings of the above, where "/" becomes "-".
+	machineTagSnippet           = NumberSnippet + "(?:-" + ContainerTypeSnippet + "-" + NumberSnippet + ")*"
+	storageAttachmentTagSnippet = "(?:(?:" + machineTagSnippet + "|" + ApplicationSnippet + "-" + NumberSnippet + ")-)?" + NumberSnippet
+
+	// Cloud credential and region tags escape "_" as "%5f".
+	cloudTagSnippet               = "[a-zA-Z0-9](?:[a-zA-Z0-9.-]|%5f)*"
+	cloudCredentialNameTagSnippet = "[a-zA-Z](?:[a-zA-Z0-9.@+-]|%5f)*"
+	endpointTagSnippet            = ApplicationSnippet + "\\." + RelationSnippet
+)
+
+// kindCatalogue lists every kind accepted by ParseTag. When adding a kind
+// to validKinds, add it here too. Action, IP address and payload ids are
+// checked with utils.IsValidUUIDString, which accepts any UUID version,
+// so they use utils.UUIDSnippet rather than uuidSnippet.
+var kindCatalogue = []kindEntry{
+	kind("Action", ActionSnippet+"|"+utils.UUIDSnippet, NewActionTag, "42", exampleUUID),
+	kind("Application", ApplicationSnippet, NewApplicationTag, "wordpress", "mysql-k8s"),
+	kind("Application offer", uuidSnippet, NewApplicationOfferTag, exampleUUID).uuid(),
+	kind("CAAS model", uuidSnippet, NewCAASModelTag, exampleUUID).uuid(),
+	kind("Charm",
+		"(?:"+CharmHubSchema+"|"+LocalSchema+"):(?:"+CharmArchitectureSnippet+"/)?(?:"+CharmSeriesSnippet+"/)?"+CharmNameSnippet+"(?:-"+NumberSnippet+")?",
+		NewCharmTag, "ch:amd64/jammy/mysql-42", "local:wordpress").
+		tag("(?:" + CharmHubSchema + "|" + LocalSchema + ")_(?:" + CharmArchitectureSnippet + "_)?(?:" + CharmSeriesSnippet + "_)?" + CharmNameSnippet + "(?:-" + NumberSnippet + ")?"),
+	kind("Cloud", cloudSnippet, NewCloudTag, "aws", "manual_cloud"),
+	kind("Cloud credential", cloudSnippet+"/"+validUserSnippet+"/"+cloudCredentialNameSnippet,
+		NewCloudCredentialTag, "aws/bob/default", "google/bob@external/foo_bar").
+		tag(cloudTagSnippet + "_" + validUserSnippet + "_" + cloudCredentialNameTagSnippet),
+	kind("Cloud region", cloudSnippet+"/"+cloudRegionNameSnippet, NewCloudRegionTag, "aws/us-east-1").
+		tag(cloudTagSnippet + "_" + cloudTagSnippet),
+	kind("Controller", uuidSnippet, NewControllerTag, exampleUUID).uuid(),
+	kind("Controller agent", NumberSnippet, NewControllerAgentTag, "0"),
+	kind("Endpoint", endpointSnippet, NewEndpointTag, "mysql:db").
+		tag(endpointTagSnippet),
+	kind("Environment", uuidSnippet, NewEnvironTag, exampleUUID).deprecated().uuid(),
+	kind("Filesystem", storageAttachmentSnippet, NewFilesystemTag, "0", "0/lxd/1/2", "wordpress/0/3").
+		tag(storageAttachmentTagSnippet),
+	kind("IP address", utils.UUIDSnippet, NewIPAddressTag, exampleUUID).uuid(),
+	kind("Link-layer device", MachineSnippet+"/"+LinkLayerDeviceNameSnippet, NewLinkLayerDeviceTag, "0/eth0", "0/lxd/1/br-eth0.100").
+		tag(machineTagSnippet + "#" + LinkLayerDeviceNameSnippet),
+	kind("Machine", MachineSnippet, NewMachineTag, "0", "0/lxd/1").
+		tag(machineTagSnippet),
+	kind("Model", uuidSnippet, NewModelTag, exampleUUID).uuid(),
+	kind("Operation", OperationSnippet, NewOperationTag, "7"),
+	kind("Payload", payloadClass+"|"+utils.UUIDSnippet, NewPayloadTag, "my-payload", exampleUUID),
+	kind("Relation", endpointSnippet+"(?: "+endpointSnippet+")?", NewRelationTag, "wordpress:db mysql:server", "riak:ring").
+		tag(endpointTagSnippet + "(?:#" + endpointTagSnippet + ")?"),
+	kind("Resource", ApplicationSnippet+"/"+ResourceNameSnippet+"(?:/"+NumberSnippet+")?", NewResourceTag, "mysql/data", "mysql/data/3").
+		tag(ApplicationSnippet + "\\." + ResourceNameSnippet + "(?:\\." + NumberSnippet + ")?"),
+	kind("Secret", SecretSnippet, NewSecretTag, "9m4e2mr0ui3e8a215n4g"),
+	kind("Space", UUIDv7Snippet+"|"+SpaceSnippet, NewSpaceTag, exampleUUIDv7, "dmz"),
+	kind("Storage", StorageNameSnippet+"/"+NumberSnippet, NewStorageTag, "data/0").
+		tag(StorageNameSnippet + "-" + NumberSnippet),
+	kind("Subnet", UUIDv7Snippet+"|"+NumberSnippet, NewSubnetTag, exampleUUIDv7, "16"),
+	kind("Unit", ApplicationSnippet+"/"+NumberSnippet, NewUnitTag, "wordpress/0").
+		tag(ApplicationSnippet + "-" + NumberSnippet),
+	kind("User", validUserSnippet, NewUserTag, "bob", "bob@external"),
+	kind("Volume", storageAttachmentSnippet, NewVolumeTag, "0", "0/lxd/1/2", "wordpress/0/3").
+		tag(storageAttachmentTagSnippet),
+}
+
+// Kinds returns a description of every kind of tag understood by
+// ParseTag, ordered by kind. The result may be modified by the caller.
+func Kinds() []KindInfo {
+	result := make([]KindInfo, len(kindCatalogue))
+	for i, e := range kindCatalogue {
+		info := e.info
+		info.ExampleIDs = append([]string(nil), e.info.ExampleIDs...)
+		info.ExampleTags = make([]string, len(info.ExampleIDs))
+		for j, id := range info.ExampleIDs {
+			info.ExampleTags[j] = e.newTag(id).String()
+		}
+		result[i] = info
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Kind < result[j].Kind
+	})
+	return result
+}
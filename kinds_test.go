@@ -0,0 +1,194 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/names/v6/namestest"
+)
+
+type kindsSuite struct{}
+
+var _ = gc.Suite(&kindsSuite{})
+
+var allTagKinds = []string{
+	names.ActionTagKind, names.ApplicationTagKind, names.ApplicationOfferTagKind,
+	names.CAASModelTagKind, names.CharmTagKind, names.CloudTagKind,
+	names.CloudCredentialTagKind, names.CloudRegionTagKind, names.ControllerTagKind,
+	names.ControllerAgentTagKind, names.EndpointTagKind, names.EnvironTagKind,
+	names.FilesystemTagKind, names.IPAddressTagKind, names.LinkLayerDeviceTagKind,
+	names.MachineTagKind, names.ModelTagKind, names.OperationTagKind,
+	names.PayloadTagKind, names.RelationTagKind, names.ResourceTagKind,
+	names.SecretTagKind, names.SpaceTagKind, names.StorageTagKind,
+	names.SubnetTagKind, names.UnitTagKind, names.UserTagKind, names.VolumeTagKind,
+}
+
+func (s *kindsSuite) TestKindsCoverValidKinds(c *gc.C) {
+	seen := make(map[string]bool)
+	for _, info := range names.Kinds() {
+		seen[info.Kind] = true
+	}
+	for _, kind := range allTagKinds {
+		c.Check(seen[kind], gc.Equals, true, gc.Commentf("%s", kind))
+	}
+	c.Check(seen, gc.HasLen, len(allTagKinds)-1) // controller and controller agent share a kind
+}
+
+func (s *kindsSuite) TestKindsOrdered(c *gc.C) {
+	kinds := names.Kinds()
+	for i := 1; i < len(kinds); i++ {
+		c.Check(kinds[i-1].Kind <= kinds[i].Kind, gc.Equals, true)
+	}
+}
+
+func (s *kindsSuite) TestExamples(c *gc.C) {
+	for _, info := range names.Kinds() {
+		c.Logf("kind %s (%s)", info.Kind, info.Label)
+		c.Check(info.Label, gc.Not(gc.Equals), "")
+		c.Check(info.ExampleIDs, gc.Not(gc.HasLen), 0)
+		c.Assert(info.ExampleTags, gc.HasLen, len(info.ExampleIDs))
+		pattern := regexp.MustCompile("^" + info.IDPattern + "$")
+		tagPattern := regexp.MustCompile("^" + info.Kind + "-" + info.TagPattern + "$")
+		for i, id := range info.ExampleIDs {
+			c.Check(pattern.MatchString(id), gc.Equals, true, gc.Commentf("%q", id))
+			c.Check(tagPattern.MatchString(info.ExampleTags[i]), gc.Equals, true, gc.Commentf("%q", info.ExampleTags[i]))
+			tag, err := names.ParseTag(info.ExampleTags[i])
+			c.Assert(err, gc.IsNil)
+			c.Check(reflect.TypeOf(tag), gc.Equals, info.Type)
+			c.Check(tag.Kind(), gc.Equals, info.Kind)
+			c.Check(tag.Id(), gc.Equals, id)
+		}
+	}
+}
+
+func (s *kindsSuite) TestPatternRejectsOtherKinds(c *gc.C) {
+	pattern := func(kind string) *regexp.Regexp {
+		for _, info := range names.Kinds() {
+			if info.Kind == kind {
+				return regexp.MustCompile("^" + info.IDPattern + "$")
+			}
+		}
+		c.Fatalf("kind %q not found", kind)
+		return nil
+	}
+	c.Check(pattern(names.UnitTagKind).MatchString("wordpress"), gc.Equals, false)
+	c.Check(pattern(names.SubnetTagKind).MatchString("16x"), gc.Equals, false)
+	c.Check(pattern(names.ActionTagKind).MatchString("42"+"f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, false)
+}
+
+// kindValidators holds the IsValid* function of each kind, keyed by tag
+// type.
+var kindValidators = map[reflect.Type]func(string) bool{
+	reflect.TypeOf(names.ActionTag{}):           names.IsValidAction,
+	reflect.TypeOf(names.ApplicationTag{}):      names.IsValidApplication,
+	reflect.TypeOf(names.ApplicationOfferTag{}): names.IsValidApplicationOffer,
+	reflect.TypeOf(names.CAASModelTag{}):        names.IsValidCAASModel,
+	reflect.TypeOf(names.CharmTag{}):            names.IsValidCharm,
+	reflect.TypeOf(names.CloudTag{}):            names.IsValidCloud,
+	reflect.TypeOf(names.CloudCredentialTag{}):  names.IsValidCloudCredential,
+	reflect.TypeOf(names.CloudRegionTag{}):      names.IsValidCloudRegion,
+	reflect.TypeOf(names.ControllerTag{}):       names.IsValidController,
+	reflect.TypeOf(names.ControllerAgentTag{}):  names.IsValidControllerAgent,
+	reflect.TypeOf(names.EndpointTag{}):         names.IsValidEndpoint,
+	reflect.TypeOf(names.EnvironTag{}):          names.IsValidEnvironment,
+	reflect.TypeOf(names.FilesystemTag{}):       names.IsValidFilesystem,
+	reflect.TypeOf(names.IPAddressTag{}):        names.IsValidIPAddress,
+	reflect.TypeOf(names.LinkLayerDeviceTag{}):  names.IsValidLinkLayerDevice,
+	reflect.TypeOf(names.MachineTag{}):          names.IsValidMachine,
+	reflect.TypeOf(names.ModelTag{}):            names.IsValidModel,
+	reflect.TypeOf(names.OperationTag{}):        names.IsValidOperation,
+	reflect.TypeOf(names.PayloadTag{}):          isValidPayload,
+	reflect.TypeOf(names.RelationTag{}):         names.IsValidRelation,
+	reflect.TypeOf(names.ResourceTag{}):         names.IsValidResource,
+	reflect.TypeOf(names.SecretTag{}):           names.IsValidSecret,
+	reflect.TypeOf(names.SpaceTag{}):            names.IsValidSpace,
+	reflect.TypeOf(names.StorageTag{}):          names.IsValidStorage,
+	reflect.TypeOf(names.SubnetTag{}):           names.IsValidSubnet,
+	reflect.TypeOf(names.UnitTag{}):             names.IsValidUnit,
+	reflect.TypeOf(names.UserTag{}):             names.IsValidUser,
+	reflect.TypeOf(names.VolumeTag{}):           names.IsValidVolume,
+}
+
+// uuidNearMisses are UUID-like ids that some kinds accept and others
+// reject, checked against every kind.
+var uuidNearMisses = []string{
+	"00000000-0000-0000-0000-000000000000",
+	"f47ac10b-58cc-0372-a567-0e02b2c3d479",
+	"f47ac10b-58cc-4372-c567-0e02b2c3d479",
+	"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+	"xf47ac10b-58cc-4372-a567-0e02b2c3d479",
+}
+
+func (s *kindsSuite) TestPatternsMatchValidation(c *gc.C) {
+	g := namestest.NewGenerator(1)
+	for _, info := range names.Kinds() {
+		valid, ok := kindValidators[info.Type]
+		c.Assert(ok, gc.Equals, true, gc.Commentf("no validator for %v", info.Type))
+		pattern := regexp.MustCompile("^" + info.IDPattern + "$")
+
+		ids := append(idSeeds(info.Type), uuidNearMisses...)
+		ids = append(ids, fuzzCorpus(c, "Fuzz"+info.Type.Name())...)
+		for i := 0; i < 50; i++ {
+			ids = append(ids, g.Tag().Id())
+		}
+		for _, id := range ids {
+			if valid(id) {
+				// Some kinds apply checks beyond their pattern, so only
+				// valid ids must match.
+				c.Check(pattern.MatchString(id), gc.Equals, true, gc.Commentf("%s id %q", info.Kind, id))
+			}
+		}
+	}
+}
+
+// fuzzCorpus returns the string inputs saved in testdata for the named
+// fuzz target.
+func fuzzCorpus(c *gc.C, target string) []string {
+	files, err := filepath.Glob(filepath.Join("testdata", "fuzz", target, "*"))
+	c.Assert(err, gc.IsNil)
+	var inputs []string
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		c.Assert(err, gc.IsNil)
+		for _, line := range strings.Split(string(data), "\n") {
+			if quoted, ok := strings.CutPrefix(line, "string("); ok {
+				input, err := strconv.Unquote(strings.TrimSuffix(quoted, ")"))
+				c.Assert(err, gc.IsNil)
+				inputs = append(inputs, input)
+			}
+		}
+	}
+	return inputs
+}
+
+func (s *kindsSuite) TestFlags(c *gc.C) {
+	for _, info := range names.Kinds() {
+		switch info.Type {
+		case reflect.TypeOf(names.EnvironTag{}):
+			c.Check(info.Deprecated, gc.Equals, true)
+			c.Check(info.UUID, gc.Equals, true)
+		case reflect.TypeOf(names.ModelTag{}), reflect.TypeOf(names.ControllerTag{}):
+			c.Check(info.Deprecated, gc.Equals, false)
+			c.Check(info.UUID, gc.Equals, true)
+		case reflect.TypeOf(names.UnitTag{}), reflect.TypeOf(names.SpaceTag{}):
+			c.Check(info.Deprecated, gc.Equals, false)
+			c.Check(info.UUID, gc.Equals, false)
+		}
+	}
+}
+
+func (s *kindsSuite) TestKindsReturnsCopies(c *gc.C) {
+	kinds := names.Kinds()
+	kinds[0].ExampleIDs[0] = "mutated"
+	c.Assert(names.Kinds()[0].ExampleIDs[0], gc.Not(gc.Equals), "mutated")
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+// LegacyIDTable maps space and subnet tags using deprecated Juju 3 IDs
+// onto their UUIDv7 replacements. It refuses mappings that would make
+// a rewrite lossy, such as two legacy tags sharing a replacement.
+type LegacyIDTable struct {
+	forward map[Tag]Tag
+	reverse map[Tag]Tag
+}
+
+// NewLegacyIDTable returns an empty LegacyIDTable.
+func NewLegacyIDTable() *LegacyIDTable {
+	return &LegacyIDTable{
+		forward: make(map[Tag]Tag),
+		reverse: make(map[Tag]Tag),
+	}
+}
+
+// AddSpace records that the legacy space tag is replaced by the given
+// UUIDv7 space tag.
+func (t *LegacyIDTable) AddSpace(legacy, replacement SpaceTag) error {
+	if !legacy.IsLegacy() {
+		return fmt.Errorf("space %q is not a legacy space", legacy.Id())
+	}
+	if replacement.IsLegacy() {
+		return fmt.Errorf("replacement space %q is a legacy space", replacement.Id())
+	}
+	return t.add(legacy, replacement)
+}
+
+// AddSubnet records that the legacy subnet tag is replaced by the given
+// UUIDv7 subnet tag.
+func (t *LegacyIDTable) AddSubnet(legacy, replacement SubnetTag) error {
+	if !legacy.IsLegacy() {
+		return fmt.Errorf("subnet %q is not a legacy subnet", legacy.Id())
+	}
+	if replacement.IsLegacy() {
+		return fmt.Errorf("replacement subnet %q is a legacy subnet", replacement.Id())
+	}
+	return t.add(legacy, replacement)
+}
+
+func (t *LegacyIDTable) add(legacy, replacement Tag) error {
+	if existing, ok := t.forward[legacy]; ok && existing != replacement {
+		return fmt.Errorf("%s %q already mapped to %q", legacy.Kind(), legacy.Id(), existing.Id())
+	}
+	if existing, ok := t.reverse[replacement]; ok && existing != legacy {
+		return fmt.Errorf("%s %q already replaces %q", replacement.Kind(), replacement.Id(), existing.Id())
+	}
+	t.forward[legacy] = replacement
+	t.reverse[replacement] = legacy
+	return nil
+}
+
+// Len returns the number of mappings in the table.
+func (t *LegacyIDTable) Len() int {
+	return len(t.forward)
+}
+
+// Translate returns the replacement for tag, and a boolean indicating
+// whether or not one was found. Tags which are not legacy space or
+// subnet tags are returned unchanged, with true.
+func (t *LegacyIDTable) Translate(tag Tag) (Tag, bool) {
+	if !isLegacyID(tag) {
+		return tag, true
+	}
+	replacement, ok := t.forward[tag]
+	return replacement, ok
+}
+
+// RewriteSet returns a new Set with every legacy space and subnet tag
+// in s replaced. Other tags are copied unchanged. It returns an error,
+// naming the first such tag in sorted order, if any legacy tag has no
+// replacement.
+func (t *LegacyIDTable) RewriteSet(s Set) (Set, error) {
+	result := make(Set)
+	var missing []Tag
+	for value := range s {
+		replacement, ok := t.Translate(value)
+		if !ok {
+			missing = append(missing, value)
+			continue
+		}
+		result[replacement] = true
+	}
+	if len(missing) > 0 {
+		first := NewSet(missing...).SortedValues()[0]
+		return nil, fmt.Errorf("no replacement for %s %q (%d legacy tags unmapped)", first.Kind(), first.Id(), len(missing))
+	}
+	return result, nil
+}
+
+func isLegacyID(tag Tag) bool {
+	switch tag := tag.(type) {
+	case SpaceTag:
+		return tag.IsLegacy()
+	case SubnetTag:
+		return tag.IsLegacy()
+	}
+	return false
+}
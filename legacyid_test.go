@@ -0,0 +1,96 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type legacyIDSuite struct{}
+
+var _ = gc.Suite(&legacyIDSuite{})
+
+const (
+	spaceUUID1  = "0195847b-95bb-7ca1-a7ee-2211d802d5b3"
+	spaceUUID2  = "0195847b-95bb-7ca1-a7ee-2211d802d5b4"
+	subnetUUID1 = "0195847b-95bb-7ca1-a7ee-2211d802d5c0"
+)
+
+func (s *legacyIDSuite) TestAddRejectsWrongForms(c *gc.C) {
+	table := names.NewLegacyIDTable()
+	err := table.AddSpace(names.NewSpaceTag(spaceUUID1), names.NewSpaceTag(spaceUUID2))
+	c.Assert(err, gc.ErrorMatches, `space ".*" is not a legacy space`)
+	err = table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag("beta"))
+	c.Assert(err, gc.ErrorMatches, `replacement space "beta" is a legacy space`)
+	err = table.AddSubnet(names.NewSubnetTag(subnetUUID1), names.NewSubnetTag(subnetUUID1))
+	c.Assert(err, gc.ErrorMatches, `subnet ".*" is not a legacy subnet`)
+	err = table.AddSubnet(names.NewSubnetTag("1"), names.NewSubnetTag("2"))
+	c.Assert(err, gc.ErrorMatches, `replacement subnet "2" is a legacy subnet`)
+	c.Assert(table.Len(), gc.Equals, 0)
+}
+
+func (s *legacyIDSuite) TestAddRejectsConflicts(c *gc.C) {
+	table := names.NewLegacyIDTable()
+	c.Assert(table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag(spaceUUID1)), jc.ErrorIsNil)
+	// Re-adding the same mapping is fine.
+	c.Assert(table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag(spaceUUID1)), jc.ErrorIsNil)
+
+	err := table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag(spaceUUID2))
+	c.Assert(err, gc.ErrorMatches, `space "alpha" already mapped to "`+spaceUUID1+`"`)
+	err = table.AddSpace(names.NewSpaceTag("beta"), names.NewSpaceTag(spaceUUID1))
+	c.Assert(err, gc.ErrorMatches, `space "`+spaceUUID1+`" already replaces "alpha"`)
+	c.Assert(table.Len(), gc.Equals, 1)
+}
+
+func (s *legacyIDSuite) TestTranslate(c *gc.C) {
+	table := names.NewLegacyIDTable()
+	c.Assert(table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag(spaceUUID1)), jc.ErrorIsNil)
+	c.Assert(table.AddSubnet(names.NewSubnetTag("3"), names.NewSubnetTag(subnetUUID1)), jc.ErrorIsNil)
+
+	tag, ok := table.Translate(names.NewSpaceTag("alpha"))
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(tag, gc.Equals, names.NewSpaceTag(spaceUUID1))
+
+	tag, ok = table.Translate(names.NewSubnetTag("3"))
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(tag, gc.Equals, names.NewSubnetTag(subnetUUID1))
+
+	tag, ok = table.Translate(names.NewMachineTag("3"))
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(tag, gc.Equals, names.NewMachineTag("3"))
+
+	_, ok = table.Translate(names.NewSpaceTag("beta"))
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *legacyIDSuite) TestRewriteSet(c *gc.C) {
+	table := names.NewLegacyIDTable()
+	c.Assert(table.AddSpace(names.NewSpaceTag("alpha"), names.NewSpaceTag(spaceUUID1)), jc.ErrorIsNil)
+	c.Assert(table.AddSubnet(names.NewSubnetTag("3"), names.NewSubnetTag(subnetUUID1)), jc.ErrorIsNil)
+
+	set := names.NewSet(
+		names.NewSpaceTag("alpha"),
+		names.NewSpaceTag(spaceUUID2),
+		names.NewSubnetTag("3"),
+		names.NewMachineTag("0"),
+	)
+	rewritten, err := table.RewriteSet(set)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rewritten, jc.DeepEquals, names.NewSet(
+		names.NewSpaceTag(spaceUUID1),
+		names.NewSpaceTag(spaceUUID2),
+		names.NewSubnetTag(subnetUUID1),
+		names.NewMachineTag("0"),
+	))
+	// The original set is unchanged.
+	c.Assert(set.Contains(names.NewSpaceTag("alpha")), jc.IsTrue)
+
+	set.Add(names.NewSubnetTag("4"))
+	set.Add(names.NewSpaceTag("beta"))
+	_, err = table.RewriteSet(set)
+	c.Assert(err, gc.ErrorMatches, `no replacement for space "beta" \(2 legacy tags unmapped\)`)
+}
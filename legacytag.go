@@ -0,0 +1,35 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import "strings"
+
+// LegacyServiceTagKind is the kind used for application tags before
+// applications were called applications. It is only understood by
+// ParseLegacyTag.
+const LegacyServiceTagKind = "service"
+
+// ParseLegacyTag parses tag as ParseTag does, but also accepts the
+// historic "service" and "environment" kinds, returning them as an
+// ApplicationTag and a ModelTag respectively. The returned alias is the
+// legacy kind that was translated, or "" if tag used a current kind.
+//
+// ParseTag itself remains strict; this is intended for reading old
+// logs and backups only.
+func ParseLegacyTag(tag string) (Tag, string, error) {
+	if id, ok := strings.CutPrefix(tag, LegacyServiceTagKind+"-"); ok {
+		if !IsValidApplication(id) {
+			return nil, "", invalidTagError(tag, LegacyServiceTagKind)
+		}
+		return NewApplicationTag(id), LegacyServiceTagKind, nil
+	}
+	t, err := ParseTag(tag)
+	if err != nil {
+		return nil, "", err
+	}
+	if et, ok := t.(EnvironTag); ok {
+		return et.ModelTag(), EnvironTagKind, nil
+	}
+	return t, "", nil
+}
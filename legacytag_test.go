@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type legacyTagSuite struct{}
+
+var _ = gc.Suite(&legacyTagSuite{})
+
+var parseLegacyTagTests = []struct {
+	tag      string
+	expected names.Tag
+	alias    string
+	err      string
+}{{
+	tag:      "service-wordpress",
+	expected: names.NewApplicationTag("wordpress"),
+	alias:    names.LegacyServiceTagKind,
+}, {
+	tag:      "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+	alias:    names.EnvironTagKind,
+}, {
+	tag:      "application-wordpress",
+	expected: names.NewApplicationTag("wordpress"),
+}, {
+	tag:      "unit-wordpress-0",
+	expected: names.NewUnitTag("wordpress/0"),
+}, {
+	tag: "service-wordpress-0",
+	err: `"service-wordpress-0" is not a valid service tag`,
+}, {
+	tag: "service",
+	err: `"service" is not a valid tag`,
+}, {
+	tag: "environment-foo",
+	err: `"environment-foo" is not a valid environment tag`,
+}}
+
+func (s *legacyTagSuite) TestParseLegacyTag(c *gc.C) {
+	for i, t := range parseLegacyTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, alias, err := names.ParseLegacyTag(t.tag)
+		if t.err != "" {
+			c.Check(err, gc.ErrorMatches, t.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(got, gc.Equals, t.expected)
+		c.Check(alias, gc.Equals, t.alias)
+	}
+}
+
+func (s *legacyTagSuite) TestParseTagStaysStrict(c *gc.C) {
+	_, err := names.ParseTag("service-wordpress")
+	c.Assert(err, gc.ErrorMatches, `"service-wordpress" is not a valid tag`)
+
+	tag, err := names.ParseTag("environment-f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag, gc.FitsTypeOf, names.EnvironTag{})
+}
+
+func (s *legacyTagSuite) TestEnvironTagModelTag(c *gc.C) {
+	uuid := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	c.Assert(names.NewEnvironTag(uuid).ModelTag(), gc.Equals, names.NewModelTag(uuid))
+	c.Assert(names.EnvironTag{}.ModelTag(), gc.Equals, names.ModelTag{})
+}
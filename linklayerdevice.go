@@ -0,0 +1,212 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// LinkLayerDeviceTagKind is used as the prefix for the string
+// representation of link-layer device tags.
+const LinkLayerDeviceTagKind = "linklayerdevice"
+
+const (
+	// LinkLayerDeviceNameSnippet is a non-compiled regexp that can be
+	// composed with other snippets for validating network interface
+	// names. Names are further limited to MaxLinkLayerDeviceNameLength.
+	LinkLayerDeviceNameSnippet = "(?:[a-zA-Z0-9][a-zA-Z0-9._-]*)"
+
+	// MaxLinkLayerDeviceNameLength is the maximum length of a Linux
+	// network interface name (IFNAMSIZ, less the terminating NUL).
+	MaxLinkLayerDeviceNameLength = 15
+
+	// BridgePrefix is the prefix Juju gives to bridges it creates
+	// over an existing device.
+	BridgePrefix = "br-"
+
+	minVLAN = 1
+	maxVLAN = 4094
+)
+
+// Link-layer device ids have the format "machine/device", for example
+// "0/lxd/1/eth0". Device names cannot contain "/", so the device is
+// always the last segment.
+// Link-layer device tags have the format "linklayerdevice-machine#device",
+// for example "linklayerdevice-0-lxd-1#eth0", as both machine tag
+// suffixes and device names may contain "-".
+var (
+	validLinkLayerDevice     = regexp.MustCompile("^(" + MachineSnippet + ")/(" + LinkLayerDeviceNameSnippet + ")$")
+	validLinkLayerDeviceName = regexp.MustCompile("^" + LinkLayerDeviceNameSnippet + "$")
+	vlanDeviceName           = regexp.MustCompile(`^(.+)\.([0-9]+)$`)
+)
+
+// IsValidLinkLayerDeviceName returns whether name is a valid network
+// interface name.
+func IsValidLinkLayerDeviceName(name string) bool {
+	return len(name) <= MaxLinkLayerDeviceNameLength && validLinkLayerDeviceName.MatchString(name)
+}
+
+// ValidateLinkLayerDeviceName returns an error explaining why name is
+// not a valid network interface name, or nil if it is valid.
+func ValidateLinkLayerDeviceName(name string) error {
+	if IsValidLinkLayerDeviceName(name) {
+		return nil
+	}
+	if name == "" {
+		return errors.Errorf("invalid link-layer device name %q, empty", name)
+	}
+	if len(name) > MaxLinkLayerDeviceNameLength {
+		return errors.Errorf("invalid link-layer device name %q, longer than %d characters", name, MaxLinkLayerDeviceNameLength)
+	}
+	index := strings.IndexFunc(name, invalidRuneForLinkLayerDeviceName)
+	if index < 0 {
+		return errors.Errorf("invalid link-layer device name %q, must start with a letter or digit", name)
+	}
+	invalidRune := []rune(name[index:])[0]
+	return errors.Errorf("invalid link-layer device name %q, unexpected character %q", name, invalidRune)
+}
+
+func invalidRuneForLinkLayerDeviceName(r rune) bool {
+	if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+		return false
+	}
+	return r != '.' && r != '_' && r != '-'
+}
+
+// IsValidLinkLayerDevice returns whether id is a valid link-layer
+// device id.
+func IsValidLinkLayerDevice(id string) bool {
+	parts := validLinkLayerDevice.FindStringSubmatch(id)
+	return parts != nil && len(parts[2]) <= MaxLinkLayerDeviceNameLength
+}
+
+// LinkLayerDeviceTag represents a network interface of a machine.
+type LinkLayerDeviceTag struct {
+	machine MachineTag
+	name    string
+}
+
+func (t LinkLayerDeviceTag) Kind() string { return LinkLayerDeviceTagKind }
+
+func (t LinkLayerDeviceTag) String() string {
+	return t.Kind() + "-" + t.machine.id + "#" + t.name
+}
+
+// Id implements Tag.Id. It returns the empty string if t is zero.
+func (t LinkLayerDeviceTag) Id() string {
+	if t.name == "" {
+		return ""
+	}
+	return t.machine.Id() + "/" + t.name
+}
+
+// Machine returns the tag of the machine the device belongs to.
+func (t LinkLayerDeviceTag) Machine() MachineTag { return t.machine }
+
+// Name returns the device name, excluding the machine.
+func (t LinkLayerDeviceTag) Name() string { return t.name }
+
+// VLAN returns the tag of the device a VLAN device is on, and its
+// VLAN number, for VLAN devices named "<parent>.<vlan>" such as
+// "bond0.100". The boolean is false if the device is not a VLAN
+// device.
+func (t LinkLayerDeviceTag) VLAN() (LinkLayerDeviceTag, int, bool) {
+	parts := vlanDeviceName.FindStringSubmatch(t.name)
+	if parts == nil || !IsValidLinkLayerDeviceName(parts[1]) {
+		return LinkLayerDeviceTag{}, 0, false
+	}
+	vlan, err := strconv.Atoi(parts[2])
+	if err != nil || vlan < minVLAN || vlan > maxVLAN {
+		return LinkLayerDeviceTag{}, 0, false
+	}
+	return LinkLayerDeviceTag{machine: t.machine, name: parts[1]}, vlan, true
+}
+
+// Bridged returns the tag of the device a Juju-created bridge was
+// created over, for bridges named with BridgePrefix such as "br-eth0".
+// The boolean is false if the device is not such a bridge.
+func (t LinkLayerDeviceTag) Bridged() (LinkLayerDeviceTag, bool) {
+	name := strings.TrimPrefix(t.name, BridgePrefix)
+	if name == t.name || !IsValidLinkLayerDeviceName(name) {
+		return LinkLayerDeviceTag{}, false
+	}
+	return LinkLayerDeviceTag{machine: t.machine, name: name}, true
+}
+
+// Parent returns the tag of the device that a VLAN device or
+// Juju-created bridge sits on, and a boolean indicating whether
+// or not the device has such a parent.
+func (t LinkLayerDeviceTag) Parent() (LinkLayerDeviceTag, bool) {
+	if parent, _, ok := t.VLAN(); ok {
+		return parent, true
+	}
+	return t.Bridged()
+}
+
+// VLANDevice returns the tag of the VLAN device with the given
+// number on this device. It returns an error if the VLAN number is
+// out of range or the resulting name would be too long.
+func (t LinkLayerDeviceTag) VLANDevice(vlan int) (LinkLayerDeviceTag, error) {
+	if vlan < minVLAN || vlan > maxVLAN {
+		return LinkLayerDeviceTag{}, errors.Errorf("VLAN %d out of range (%d-%d)", vlan, minVLAN, maxVLAN)
+	}
+	return t.child(fmt.Sprintf("%s.%d", t.name, vlan))
+}
+
+// BridgeDevice returns the tag of the bridge Juju would create over
+// this device. It returns an error if the resulting name would be
+// too long.
+func (t LinkLayerDeviceTag) BridgeDevice() (LinkLayerDeviceTag, error) {
+	return t.child(BridgePrefix + t.name)
+}
+
+func (t LinkLayerDeviceTag) child(name string) (LinkLayerDeviceTag, error) {
+	if err := ValidateLinkLayerDeviceName(name); err != nil {
+		return LinkLayerDeviceTag{}, err
+	}
+	return LinkLayerDeviceTag{machine: t.machine, name: name}, nil
+}
+
+// NewLinkLayerDeviceTag returns the tag for the link-layer device with
+// the given id. It will panic if the given id is not valid.
+func NewLinkLayerDeviceTag(id string) LinkLayerDeviceTag {
+	if !IsValidLinkLayerDevice(id) {
+		panic(fmt.Sprintf("%q is not a valid link-layer device id", id))
+	}
+	i := strings.LastIndex(id, "/")
+	return LinkLayerDeviceTag{machine: NewMachineTag(id[:i]), name: id[i+1:]}
+}
+
+// ParseLinkLayerDeviceTag parses a link-layer device tag string.
+func ParseLinkLayerDeviceTag(linkLayerDeviceTag string) (LinkLayerDeviceTag, error) {
+	return ParseAs[LinkLayerDeviceTag](linkLayerDeviceTag)
+}
+
+func linkLayerDeviceTagSuffixToId(s string) string {
+	i := strings.Index(s, "#")
+	if i < 0 {
+		return s
+	}
+	return machineTagSuffixToId(s[:i]) + "/" + s[i+1:]
+}
+
+// TryNewLinkLayerDeviceTag returns the tag for the given id, or an error if id is
+// not a valid link-layer device id.
+func TryNewLinkLayerDeviceTag(id string) (LinkLayerDeviceTag, error) {
+	if !IsValidLinkLayerDevice(id) {
+		return LinkLayerDeviceTag{}, fmt.Errorf("%q is not a valid link-layer device id", id)
+	}
+	return NewLinkLayerDeviceTag(id), nil
+}
+
+// MustNewLinkLayerDeviceTag returns the tag for the given id.
+// It will panic if id is not a valid link-layer device id.
+func MustNewLinkLayerDeviceTag(id string) LinkLayerDeviceTag {
+	return mustTag(TryNewLinkLayerDeviceTag(id))
+}
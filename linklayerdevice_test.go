@@ -0,0 +1,138 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type linkLayerDeviceSuite struct{}
+
+var _ = gc.Suite(&linkLayerDeviceSuite{})
+
+var linkLayerDeviceNameTests = []struct {
+	name string
+	err  string
+}{
+	{name: "eth0"},
+	{name: "enp0s31f6"},
+	{name: "br-eth0"},
+	{name: "bond0.100"},
+	{name: "vlan_10"},
+	{name: "123456789012345"},
+	{name: "", err: `invalid link-layer device name "", empty`},
+	{name: "1234567890123456", err: `invalid link-layer device name "1234567890123456", longer than 15 characters`},
+	{name: "eth0:1", err: `invalid link-layer device name "eth0:1", unexpected character ':'`},
+	{name: "eth 0", err: `invalid link-layer device name "eth 0", unexpected character ' '`},
+	{name: "eth/0", err: `invalid link-layer device name "eth/0", unexpected character '/'`},
+	{name: ".", err: `invalid link-layer device name ".", must start with a letter or digit`},
+	{name: "-eth0", err: `invalid link-layer device name "-eth0", must start with a letter or digit`},
+}
+
+func (s *linkLayerDeviceSuite) TestValidateLinkLayerDeviceName(c *gc.C) {
+	for i, test := range linkLayerDeviceNameTests {
+		c.Logf("test %d: %q", i, test.name)
+		err := names.ValidateLinkLayerDeviceName(test.name)
+		c.Check(names.IsValidLinkLayerDeviceName(test.name), gc.Equals, test.err == "")
+		if test.err == "" {
+			c.Check(err, jc.ErrorIsNil)
+		} else {
+			c.Check(err, gc.ErrorMatches, test.err)
+		}
+	}
+}
+
+var linkLayerDeviceIdTests = []struct {
+	id      string
+	valid   bool
+	machine string
+	name    string
+	tag     string
+}{
+	{id: "0/eth0", valid: true, machine: "0", name: "eth0", tag: "linklayerdevice-0#eth0"},
+	{id: "0/lxd/1/br-eth0", valid: true, machine: "0/lxd/1", name: "br-eth0", tag: "linklayerdevice-0-lxd-1#br-eth0"},
+	{id: "12/bond0.100", valid: true, machine: "12", name: "bond0.100", tag: "linklayerdevice-12#bond0.100"},
+	{id: "0/lxd/1", valid: false},
+	{id: "0", valid: false},
+	{id: "eth0", valid: false},
+	{id: "0/1234567890123456", valid: false},
+	{id: "0/eth0:1", valid: false},
+}
+
+func (s *linkLayerDeviceSuite) TestLinkLayerDeviceIds(c *gc.C) {
+	for i, test := range linkLayerDeviceIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidLinkLayerDevice(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expect := fmt.Sprintf("%q is not a valid link-layer device id", test.id)
+			c.Check(func() { names.NewLinkLayerDeviceTag(test.id) }, gc.PanicMatches, expect)
+			continue
+		}
+		tag := names.NewLinkLayerDeviceTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Machine(), gc.Equals, names.NewMachineTag(test.machine))
+		c.Check(tag.Name(), gc.Equals, test.name)
+
+		parsed, err := names.ParseLinkLayerDeviceTag(test.tag)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *linkLayerDeviceSuite) TestVLAN(c *gc.C) {
+	parent, vlan, ok := names.NewLinkLayerDeviceTag("0/bond0.100").VLAN()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(vlan, gc.Equals, 100)
+	c.Assert(parent, gc.Equals, names.NewLinkLayerDeviceTag("0/bond0"))
+
+	for _, id := range []string{"0/eth0", "0/eth0.0", "0/eth0.4095", "0/br.eth0"} {
+		_, _, ok := names.NewLinkLayerDeviceTag(id).VLAN()
+		c.Check(ok, jc.IsFalse, gc.Commentf("%s", id))
+	}
+}
+
+func (s *linkLayerDeviceSuite) TestBridged(c *gc.C) {
+	parent, ok := names.NewLinkLayerDeviceTag("0/lxd/1/br-eth0").Bridged()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(parent, gc.Equals, names.NewLinkLayerDeviceTag("0/lxd/1/eth0"))
+
+	_, ok = names.NewLinkLayerDeviceTag("0/virbr0").Bridged()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *linkLayerDeviceSuite) TestParent(c *gc.C) {
+	parent, ok := names.NewLinkLayerDeviceTag("0/br-bond0.100").Parent()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(parent, gc.Equals, names.NewLinkLayerDeviceTag("0/br-bond0"))
+
+	parent, ok = parent.Parent()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(parent, gc.Equals, names.NewLinkLayerDeviceTag("0/bond0"))
+
+	_, ok = parent.Parent()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *linkLayerDeviceSuite) TestChildDevices(c *gc.C) {
+	eth0 := names.NewLinkLayerDeviceTag("3/eth0")
+	vlan, err := eth0.VLANDevice(42)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(vlan, gc.Equals, names.NewLinkLayerDeviceTag("3/eth0.42"))
+
+	bridge, err := eth0.BridgeDevice()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(bridge, gc.Equals, names.NewLinkLayerDeviceTag("3/br-eth0"))
+
+	_, err = eth0.VLANDevice(4095)
+	c.Assert(err, gc.ErrorMatches, `VLAN 4095 out of range \(1-4094\)`)
+
+	_, err = names.NewLinkLayerDeviceTag("3/enp0s31f6d123").BridgeDevice()
+	c.Assert(err, gc.ErrorMatches, `invalid link-layer device name "br-enp0s31f6d123", longer than 15 characters`)
+}
@@ -4,6 +4,7 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -71,17 +72,24 @@ func NewMachineTag(id string) MachineTag {
 
 // ParseMachineTag parses a machine tag string.
 func ParseMachineTag(machineTag string) (MachineTag, error) {
-	tag, err := ParseTag(machineTag)
-	if err != nil {
-		return MachineTag{}, err
-	}
-	mt, ok := tag.(MachineTag)
-	if !ok {
-		return MachineTag{}, invalidTagError(machineTag, MachineTagKind)
-	}
-	return mt, nil
+	return ParseAs[MachineTag](machineTag)
 }
 
 func machineTagSuffixToId(s string) string {
 	return strings.Replace(s, "-", "/", -1)
 }
+
+// TryNewMachineTag returns the tag for the given id, or an error if id is
+// not a valid machine id.
+func TryNewMachineTag(id string) (MachineTag, error) {
+	if !IsValidMachine(id) {
+		return MachineTag{}, fmt.Errorf("%q is not a valid machine id", id)
+	}
+	return NewMachineTag(id), nil
+}
+
+// MustNewMachineTag returns the tag for the given id.
+// It will panic if id is not a valid machine id.
+func MustNewMachineTag(id string) MachineTag {
+	return mustTag(TryNewMachineTag(id))
+}
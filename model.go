@@ -4,6 +4,7 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
 )
 
@@ -17,10 +18,10 @@ type ModelTag struct {
 	uuid string
 }
 
-var validUUID = regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)
-
 // Lowercase letters, digits and (non-leading) hyphens, as per LP:1568944 #5.
-var validModelName = regexp.MustCompile(`^[a-z0-9]+[a-z0-9-]*$`)
+const modelNameSnippet = `[a-z0-9]+[a-z0-9-]*`
+
+var validModelName = regexp.MustCompile("^" + modelNameSnippet + "$")
 
 // NewModelTag returns the tag of an model with the given model UUID.
 func NewModelTag(uuid string) ModelTag {
@@ -29,25 +30,40 @@ func NewModelTag(uuid string) ModelTag {
 
 // ParseModelTag parses an environ tag string.
 func ParseModelTag(modelTag string) (ModelTag, error) {
-	tag, err := ParseTag(modelTag)
-	if err != nil {
-		return ModelTag{}, err
+	return ParseAs[ModelTag](modelTag)
+}
+
+func (t ModelTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ModelTag) Kind() string   { return ModelTagKind }
+func (t ModelTag) Id() string     { return t.uuid }
+
+// ShortId returns the first few characters of the model UUID, for
+// display. The whole id is returned if it is shorter than that.
+func (t ModelTag) ShortId() string {
+	if len(t.uuid) < shortModelIdLength {
+		return t.uuid
 	}
-	et, ok := tag.(ModelTag)
-	if !ok {
-		return ModelTag{}, invalidTagError(modelTag, ModelTagKind)
+	return t.uuid[:shortModelIdLength]
+}
+
+// TryNewModelTag returns the tag of a model with the given model UUID,
+// or an error if uuid is not a valid model UUID.
+func TryNewModelTag(uuid string) (ModelTag, error) {
+	if !IsValidModel(uuid) {
+		return ModelTag{}, fmt.Errorf("%q is not a valid model UUID", uuid)
 	}
-	return et, nil
+	return ModelTag{uuid: uuid}, nil
 }
 
-func (t ModelTag) String() string  { return t.Kind() + "-" + t.Id() }
-func (t ModelTag) Kind() string    { return ModelTagKind }
-func (t ModelTag) Id() string      { return t.uuid }
-func (t ModelTag) ShortId() string { return t.uuid[:shortModelIdLength] }
+// MustNewModelTag returns the tag of a model with the given model UUID.
+// It will panic if uuid is not a valid model UUID.
+func MustNewModelTag(uuid string) ModelTag {
+	return mustTag(TryNewModelTag(uuid))
+}
 
 // IsValidModel returns whether id is a valid model UUID.
 func IsValidModel(id string) bool {
-	return validUUID.MatchString(id)
+	return isValidUUID(id)
 }
 
 // IsValidModelName returns whether name is a valid string safe for a model name.
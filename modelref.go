@@ -0,0 +1,101 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/juju/errors"
+)
+
+// Model references have the format "[controller:][owner/]model", for
+// example "prod", "admin/default" or "ctrl:bob@external/prod".
+var validModelRef = regexp.MustCompile(
+	"^(?:(" + controllerNameSnippet + "):)?" +
+		"(?:(" + validUserSnippet + ")/)?" +
+		"(" + modelNameSnippet + ")$",
+)
+
+// ModelRef is a reference to a model by name, as typed by users,
+// optionally qualified by its owner and the name of its controller.
+type ModelRef struct {
+	// Controller is the name of the controller hosting the model.
+	// It is empty if the reference is not qualified by controller.
+	Controller string
+
+	// Owner is the tag of the user that owns the model. It is the
+	// zero UserTag if the reference is not qualified by owner.
+	Owner UserTag
+
+	// Name is the name of the model.
+	Name string
+}
+
+// ParseModelRef parses a model reference of the form
+// "[controller:][owner/]model".
+func ParseModelRef(s string) (ModelRef, error) {
+	parts := validModelRef.FindStringSubmatch(s)
+	if parts == nil {
+		return ModelRef{}, fmt.Errorf("%q is not a valid model reference", s)
+	}
+	ref := ModelRef{Controller: parts[1], Name: parts[3]}
+	if parts[2] != "" {
+		ref.Owner = NewUserTag(parts[2])
+	}
+	return ref, nil
+}
+
+// HasOwner reports whether the reference is qualified by owner.
+func (r ModelRef) HasOwner() bool {
+	return r.Owner != UserTag{}
+}
+
+// WithOwner returns a copy of the reference qualified by the given
+// owner. It is typically used to default the owner to the current user.
+func (r ModelRef) WithOwner(owner UserTag) ModelRef {
+	r.Owner = owner
+	return r
+}
+
+// WithController returns a copy of the reference qualified by the
+// given controller name.
+func (r ModelRef) WithController(controller string) ModelRef {
+	r.Controller = controller
+	return r
+}
+
+// String returns the canonical string form of the reference. Local
+// owners are written without a domain.
+func (r ModelRef) String() string {
+	s := r.Name
+	if r.HasOwner() {
+		s = r.Owner.Id() + "/" + s
+	}
+	if r.Controller != "" {
+		s = r.Controller + ":" + s
+	}
+	return s
+}
+
+// ModelResolver looks up the UUID of a referenced model.
+type ModelResolver interface {
+	// ResolveModel returns the tag of the model the reference
+	// identifies. It should return an error satisfying
+	// errors.IsNotFound if there is no such model.
+	ResolveModel(ref ModelRef) (ModelTag, error)
+}
+
+// Resolve returns the tag of the model the reference identifies,
+// as looked up by resolver.
+func (r ModelRef) Resolve(resolver ModelResolver) (ModelTag, error) {
+	tag, err := resolver.ResolveModel(r)
+	if err != nil {
+		return ModelTag{}, errors.Annotatef(err, "resolving model %q", r.String())
+	}
+	if !IsValidModel(tag.Id()) {
+		return ModelTag{}, errors.Errorf("resolving model %q: invalid model UUID %q", r.String(), tag.Id())
+	}
+	return tag, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type modelRefSuite struct{}
+
+var _ = gc.Suite(&modelRefSuite{})
+
+var parseModelRefTests = []struct {
+	ref        string
+	controller string
+	owner      string
+	name       string
+	canonical  string
+	err        string
+}{
+	{ref: "prod", name: "prod"},
+	{ref: "admin/default", owner: "admin", name: "default"},
+	{ref: "ctrl:bob/prod", controller: "ctrl", owner: "bob", name: "prod"},
+	{ref: "ctrl:bob@external/prod-2", controller: "ctrl", owner: "bob@external", name: "prod-2"},
+	{ref: "ctrl:prod", controller: "ctrl", name: "prod"},
+	{ref: "admin@local/default", owner: "admin", name: "default", canonical: "admin/default"},
+	{ref: "", err: `"" is not a valid model reference`},
+	{ref: "Prod", err: `"Prod" is not a valid model reference`},
+	{ref: "-prod", err: `"-prod" is not a valid model reference`},
+	{ref: "admin/", err: `"admin/" is not a valid model reference`},
+	{ref: "a/prod", err: `"a/prod" is not a valid model reference`},
+	{ref: "ctrl:admin/default/x", err: `"ctrl:admin/default/x" is not a valid model reference`},
+	{ref: "Ctrl:admin/default", err: `"Ctrl:admin/default" is not a valid model reference`},
+}
+
+func (s *modelRefSuite) TestParseModelRef(c *gc.C) {
+	for i, test := range parseModelRefTests {
+		c.Logf("test %d: %q", i, test.ref)
+		ref, err := names.ParseModelRef(test.ref)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(ref.Controller, gc.Equals, test.controller)
+		c.Check(ref.HasOwner(), gc.Equals, test.owner != "")
+		if test.owner != "" {
+			c.Check(ref.Owner, gc.Equals, names.NewUserTag(test.owner))
+		}
+		c.Check(ref.Name, gc.Equals, test.name)
+		canonical := test.canonical
+		if canonical == "" {
+			canonical = test.ref
+		}
+		c.Check(ref.String(), gc.Equals, canonical)
+	}
+}
+
+func (s *modelRefSuite) TestQualify(c *gc.C) {
+	ref, err := names.ParseModelRef("prod")
+	c.Assert(err, jc.ErrorIsNil)
+	ref = ref.WithOwner(names.NewUserTag("bob")).WithController("ctrl")
+	c.Assert(ref.String(), gc.Equals, "ctrl:bob/prod")
+}
+
+type mapResolver map[string]names.ModelTag
+
+func (r mapResolver) ResolveModel(ref names.ModelRef) (names.ModelTag, error) {
+	tag, ok := r[ref.String()]
+	if !ok {
+		return names.ModelTag{}, errors.NotFoundf("model %q", ref.String())
+	}
+	return tag, nil
+}
+
+func (s *modelRefSuite) TestResolve(c *gc.C) {
+	resolver := mapResolver{
+		"admin/default": names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		"bob/broken":    names.NewModelTag("not-a-uuid"),
+	}
+
+	ref, err := names.ParseModelRef("admin@local/default")
+	c.Assert(err, jc.ErrorIsNil)
+	tag, err := ref.Resolve(resolver)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tag, gc.Equals, names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+
+	ref, err = names.ParseModelRef("admin/missing")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = ref.Resolve(resolver)
+	c.Assert(err, gc.ErrorMatches, `resolving model "admin/missing": model "admin/missing" not found`)
+	c.Assert(errors.Is(err, errors.NotFound), jc.IsTrue)
+
+	ref, err = names.ParseModelRef("bob/broken")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = ref.Resolve(resolver)
+	c.Assert(err, gc.ErrorMatches, `resolving model "bob/broken": invalid model UUID "not-a-uuid"`)
+}
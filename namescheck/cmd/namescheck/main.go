@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// The namescheck command reports misuses of tags from the names package.
+// It can be run on its own, or by go vet:
+//
+//	go vet -vettool=$(which namescheck) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/juju/names/v6/namescheck"
+)
+
+func main() { singlechecker.Main(namescheck.Analyzer) }
@@ -0,0 +1,22 @@
+module github.com/juju/names/v6/namescheck
+
+go 1.23
+
+require (
+	github.com/juju/names/v6 v6.0.0
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	github.com/juju/clock v1.0.2 // indirect
+	github.com/juju/errors v1.0.0 // indirect
+	github.com/juju/loggo v1.0.0 // indirect
+	github.com/juju/utils/v3 v3.1.0 // indirect
+	golang.org/x/crypto v0.33.0 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+)
+
+replace github.com/juju/names/v6 => ../
@@ -0,0 +1,496 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namescheck defines an analyzer that reports common misuses of
+// the names package:
+//
+//   - calls to the New*Tag constructors that panic on invalid input,
+//     on values that are neither constant nor guarded by the matching
+//     IsValid* function: the call must be in the body of an if statement
+//     whose condition checks the value, or follow one that returns when
+//     the check fails, with no assignment to the value in between;
+//   - tag strings built by hand, such as "unit-" + name;
+//   - type assertions on the result of ParseTag, where the kind's own
+//     Parse*Tag function says the same thing and gives a better error;
+//   - tags, or their String forms, printed to standard output or
+//     standard error with fmt, as tags must not be shown to users.
+//
+// The analyzer lives in its own module, so that users of names do not
+// depend on golang.org/x/tools. It can be run with go vet using the
+// namescheck command:
+//
+//	go install github.com/juju/names/v6/namescheck/cmd/namescheck@latest
+//	go vet -vettool=$(which namescheck) ./...
+package namescheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/juju/names/v6"
+)
+
+const namesPath = "github.com/juju/names/v6"
+
+// Analyzer reports misuses of the names package.
+var Analyzer = &analysis.Analyzer{
+	Name:     "namescheck",
+	Doc:      "report misuses of tags from github.com/juju/names",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var newTagFunc = regexp.MustCompile(`^New(\w*)Tag$`)
+
+// newTagGuards maps the kinds of the New*Tag constructors that panic on
+// invalid input, as named by the constructors, to the IsValid* function
+// that guards them. Constructors that accept any value are left out.
+var newTagGuards = map[string]string{
+	"Action":          "IsValidAction",
+	"Charm":           "IsValidCharm",
+	"Cloud":           "IsValidCloud",
+	"CloudCredential": "IsValidCloudCredential",
+	"CloudRegion":     "IsValidCloudRegion",
+	"ControllerAgent": "IsValidControllerAgent",
+	"Endpoint":        "IsValidEndpoint",
+	"Filesystem":      "IsValidFilesystem",
+	"IPAddress":       "IsValidIPAddress",
+	"LinkLayerDevice": "IsValidLinkLayerDevice",
+	"LocalUser":       "IsValidUserName",
+	"Operation":       "IsValidOperation",
+	"Relation":        "IsValidRelation",
+	"Resource":        "IsValidResource",
+	"Secret":          "IsValidSecret",
+	"Space":           "IsValidSpace",
+	"Storage":         "IsValidStorage",
+	"Subnet":          "IsValidSubnet",
+	"Unit":            "IsValidUnit",
+	"User":            "IsValidUser",
+	"Volume":          "IsValidVolume",
+}
+
+// tagPrefixes holds the "kind-" prefix of every tag kind, including the
+// legacy ones still found in old data.
+var tagPrefixes = func() map[string]bool {
+	prefixes := map[string]bool{
+		names.LegacyServiceTagKind + "-": true,
+	}
+	for _, info := range names.Kinds() {
+		prefixes[info.Kind+"-"] = true
+	}
+	return prefixes
+}()
+
+// userFacingPrint maps fmt's printing functions to the number of leading
+// arguments that are not values to print. The Fprint functions are only
+// user-facing when writing to standard output or standard error.
+var userFacingPrint = map[string]int{
+	"Print": 0, "Printf": 1, "Println": 0,
+	"Fprint": 1, "Fprintf": 2, "Fprintln": 1,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == namesPath {
+		return nil, nil
+	}
+	tagType := lookupTagType(pass.Pkg)
+	if tagType == nil {
+		// The package does not use names, directly or otherwise.
+		return nil, nil
+	}
+	c := &checker{pass: pass, tagType: tagType}
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil), (*ast.GenDecl)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if n.Body != nil {
+				c.checkScope(n.Body)
+			}
+		case *ast.GenDecl:
+			if n.Tok == token.VAR {
+				c.checkScope(n)
+			}
+		}
+	})
+	return nil, nil
+}
+
+// lookupTagType returns the names.Tag interface if pkg imports names,
+// directly or indirectly.
+func lookupTagType(pkg *types.Package) *types.Interface {
+	seen := make(map[*types.Package]bool)
+	var find func(*types.Package) *types.Interface
+	find = func(p *types.Package) *types.Interface {
+		if seen[p] {
+			return nil
+		}
+		seen[p] = true
+		if p.Path() == namesPath {
+			if obj, ok := p.Scope().Lookup("Tag").(*types.TypeName); ok {
+				iface, _ := obj.Type().Underlying().(*types.Interface)
+				return iface
+			}
+			return nil
+		}
+		for _, imp := range p.Imports() {
+			if iface := find(imp); iface != nil {
+				return iface
+			}
+		}
+		return nil
+	}
+	return find(pkg)
+}
+
+// guard identifies a value checked with an IsValid* function.
+type guard struct {
+	fn   string
+	expr string
+}
+
+// guardedRegion is a range of code in which the guards are known to
+// hold, having been checked at pos.
+type guardedRegion struct {
+	guards     []guard
+	pos        token.Pos
+	start, end token.Pos
+}
+
+type checker struct {
+	pass    *analysis.Pass
+	tagType *types.Interface
+}
+
+// checkScope checks the code in root, which is either a function body or
+// a package level variable declaration.
+func (c *checker) checkScope(root ast.Node) {
+	// Record the code guarded by IsValid* checks, the assignments that
+	// can invalidate them, and the variables holding the results of
+	// ParseTag, so later uses can be judged.
+	s := &scope{assigned: make(map[string][]token.Pos)}
+	parsed := make(map[types.Object]bool)
+	ast.Inspect(root, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt:
+			c.recordIf(s, n)
+		case *ast.BlockStmt:
+			c.recordEarlyReturns(s, n.List, n.Rbrace)
+		case *ast.CaseClause:
+			c.recordEarlyReturns(s, n.Body, n.End())
+		case *ast.CommClause:
+			c.recordEarlyReturns(s, n.Body, n.End())
+		case *ast.IncDecStmt:
+			s.assign(n.X)
+		case *ast.RangeStmt:
+			s.assign(n.Key, n.Value)
+		case *ast.AssignStmt:
+			s.assign(n.Lhs...)
+			c.recordParseTag(n.Lhs, n.Rhs, parsed)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			c.recordParseTag(lhs, n.Values, parsed)
+		}
+		return true
+	})
+
+	ast.Inspect(root, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			c.checkNewTag(n, s)
+			c.checkSprintf(n)
+			c.checkPrint(n)
+		case *ast.BinaryExpr:
+			c.checkConcat(n)
+		case *ast.TypeAssertExpr:
+			c.checkTypeAssert(n, parsed)
+		}
+		return true
+	})
+}
+
+// scope holds what checkScope learns about IsValid* guards.
+type scope struct {
+	regions  []guardedRegion
+	assigned map[string][]token.Pos
+}
+
+func (s *scope) assign(exprs ...ast.Expr) {
+	for _, expr := range exprs {
+		if expr != nil {
+			key := types.ExprString(expr)
+			s.assigned[key] = append(s.assigned[key], expr.Pos())
+		}
+	}
+}
+
+// guarded reports whether g holds at pos.
+func (s *scope) guarded(g guard, pos token.Pos) bool {
+	for _, r := range s.regions {
+		if pos < r.start || pos >= r.end || !containsGuard(r.guards, g) {
+			continue
+		}
+		reassigned := false
+		for _, p := range s.assigned[g.expr] {
+			if p > r.pos && p < pos {
+				reassigned = true
+				break
+			}
+		}
+		if !reassigned {
+			return true
+		}
+	}
+	return false
+}
+
+func containsGuard(guards []guard, g guard) bool {
+	for _, candidate := range guards {
+		if candidate == g {
+			return true
+		}
+	}
+	return false
+}
+
+// recordIf records the guards that hold in the branches of stmt.
+func (c *checker) recordIf(s *scope, stmt *ast.IfStmt) {
+	if guards := c.guards(stmt.Cond, true); len(guards) > 0 {
+		s.regions = append(s.regions, guardedRegion{guards, stmt.Cond.Pos(), stmt.Body.Pos(), stmt.Body.End()})
+	}
+	if stmt.Else == nil {
+		return
+	}
+	if guards := c.guards(stmt.Cond, false); len(guards) > 0 {
+		s.regions = append(s.regions, guardedRegion{guards, stmt.Cond.Pos(), stmt.Else.Pos(), stmt.Else.End()})
+	}
+}
+
+// recordEarlyReturns records the guards that hold after an if statement
+// in stmts that leaves the block when its condition is true, up to end.
+func (c *checker) recordEarlyReturns(s *scope, stmts []ast.Stmt, end token.Pos) {
+	for _, stmt := range stmts {
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Else != nil || !c.terminates(ifStmt.Body) {
+			continue
+		}
+		if guards := c.guards(ifStmt.Cond, false); len(guards) > 0 {
+			s.regions = append(s.regions, guardedRegion{guards, ifStmt.Cond.Pos(), ifStmt.End(), end})
+		}
+	}
+}
+
+// guards returns the IsValid* checks known to have passed when cond
+// evaluates to truth.
+func (c *checker) guards(cond ast.Expr, truth bool) []guard {
+	switch cond := cond.(type) {
+	case *ast.ParenExpr:
+		return c.guards(cond.X, truth)
+	case *ast.UnaryExpr:
+		if cond.Op == token.NOT {
+			return c.guards(cond.X, !truth)
+		}
+	case *ast.BinaryExpr:
+		// Both sides of a true "&&", or a false "||", are known.
+		if cond.Op == token.LAND && truth || cond.Op == token.LOR && !truth {
+			return append(c.guards(cond.X, truth), c.guards(cond.Y, truth)...)
+		}
+	case *ast.CallExpr:
+		fn := c.namesFunc(cond)
+		if truth && fn != nil && strings.HasPrefix(fn.Name(), "IsValid") && len(cond.Args) == 1 {
+			return []guard{{fn.Name(), types.ExprString(cond.Args[0])}}
+		}
+	}
+	return nil
+}
+
+// terminates reports whether block always leaves the enclosing block,
+// by returning, branching or panicking.
+func (c *checker) terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+	switch stmt := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		builtin, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Builtin)
+		return ok && builtin.Name() == "panic"
+	}
+	return false
+}
+
+// namesFunc returns the names package function called by call, if any.
+func (c *checker) namesFunc(call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != namesPath {
+		return nil
+	}
+	if sig, ok := fn.Type().(*types.Signature); !ok || sig.Recv() != nil {
+		return nil
+	}
+	return fn
+}
+
+func (c *checker) recordParseTag(lhs, rhs []ast.Expr, parsed map[types.Object]bool) {
+	if len(rhs) != 1 || len(lhs) == 0 {
+		return
+	}
+	call, ok := rhs[0].(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	if fn := c.namesFunc(call); fn == nil || fn.Name() != "ParseTag" {
+		return
+	}
+	if ident, ok := lhs[0].(*ast.Ident); ok {
+		if obj := c.pass.TypesInfo.ObjectOf(ident); obj != nil {
+			parsed[obj] = true
+		}
+	}
+}
+
+func (c *checker) checkNewTag(call *ast.CallExpr, s *scope) {
+	fn := c.namesFunc(call)
+	if fn == nil || len(call.Args) != 1 {
+		return
+	}
+	m := newTagFunc.FindStringSubmatch(fn.Name())
+	if m == nil {
+		return
+	}
+	isValid, ok := newTagGuards[m[1]]
+	if !ok {
+		return
+	}
+	arg := call.Args[0]
+	if tv, ok := c.pass.TypesInfo.Types[arg]; ok && tv.Value != nil {
+		return
+	}
+	if s.guarded(guard{isValid, types.ExprString(arg)}, call.Pos()) {
+		return
+	}
+	c.pass.Reportf(call.Pos(),
+		"names.%s may panic on %s: check it with names.%s first, or use names.TryNew%sTag",
+		fn.Name(), types.ExprString(arg), isValid, m[1])
+}
+
+func (c *checker) checkConcat(expr *ast.BinaryExpr) {
+	if expr.Op != token.ADD {
+		return
+	}
+	if s, ok := c.constString(expr.X); ok && tagPrefixes[s] {
+		c.pass.Reportf(expr.Pos(), "tag %q built by hand: use the names package to make tags", s+"...")
+	}
+}
+
+func (c *checker) checkSprintf(call *ast.CallExpr) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || fn.Name() != "Sprintf" || len(call.Args) == 0 {
+		return
+	}
+	format, ok := c.constString(call.Args[0])
+	if !ok {
+		return
+	}
+	i := strings.Index(format, "-")
+	if i > 0 && tagPrefixes[format[:i+1]] && strings.HasPrefix(format[i+1:], "%") {
+		c.pass.Reportf(call.Pos(), "tag %q built by hand: use the names package to make tags", format)
+	}
+}
+
+func (c *checker) checkTypeAssert(expr *ast.TypeAssertExpr, parsed map[types.Object]bool) {
+	ident, ok := expr.X.(*ast.Ident)
+	if !ok || expr.Type == nil || !parsed[c.pass.TypesInfo.ObjectOf(ident)] {
+		return
+	}
+	named, ok := c.pass.TypesInfo.TypeOf(expr.Type).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != namesPath {
+		return
+	}
+	parse := "Parse" + named.Obj().Name()
+	if _, ok := named.Obj().Pkg().Scope().Lookup(parse).(*types.Func); !ok {
+		return
+	}
+	c.pass.Reportf(expr.Pos(), "type assertion on the result of names.ParseTag: use names.%s", parse)
+}
+
+func (c *checker) checkPrint(call *ast.CallExpr) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+		return
+	}
+	skip, ok := userFacingPrint[fn.Name()]
+	if !ok || strings.HasPrefix(fn.Name(), "Fprint") && !c.isStdStream(call.Args[0]) {
+		return
+	}
+	for i, arg := range call.Args {
+		if i < skip {
+			continue
+		}
+		if c.isTagString(arg) || c.isTag(c.pass.TypesInfo.TypeOf(arg)) {
+			c.pass.Reportf(arg.Pos(), "tag %s printed by fmt.%s: show users the tag's Id instead", types.ExprString(arg), fn.Name())
+		}
+	}
+}
+
+// isStdStream reports whether expr is os.Stdout or os.Stderr.
+func (c *checker) isStdStream(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	v, ok := c.pass.TypesInfo.Uses[sel.Sel].(*types.Var)
+	if !ok || v.Pkg() == nil || v.Pkg().Path() != "os" {
+		return false
+	}
+	return v.Name() == "Stdout" || v.Name() == "Stderr"
+}
+
+// isTagString reports whether expr calls String on a tag.
+func (c *checker) isTagString(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "String" {
+		return false
+	}
+	return c.isTag(c.pass.TypesInfo.TypeOf(sel.X))
+}
+
+func (c *checker) isTag(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		// Only report interfaces that are, or embed, names.Tag, not
+		// fmt.Stringer and friends that tags happen to implement.
+		return types.Implements(t, c.tagType)
+	}
+	return types.Implements(t, c.tagType) || types.Implements(types.NewPointer(t), c.tagType)
+}
+
+func (c *checker) constString(expr ast.Expr) (string, bool) {
+	tv, ok := c.pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
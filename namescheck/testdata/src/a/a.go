@@ -0,0 +1,136 @@
+package a
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/juju/names/v6"
+)
+
+const wordpress = "wordpress/0"
+
+var global = names.NewUnitTag(os.Args[0]) // want `names.NewUnitTag may panic on os.Args\[0\]`
+
+func constants() {
+	names.NewUnitTag("wordpress/0")
+	names.NewUnitTag(wordpress)
+}
+
+func unchecked(name string) names.UnitTag {
+	return names.NewUnitTag(name) // want `names.NewUnitTag may panic on name: check it with names.IsValidUnit first, or use names.TryNewUnitTag`
+}
+
+func checked(name, user string) {
+	if !names.IsValidUnit(name) {
+		return
+	}
+	names.NewUnitTag(name)
+
+	names.NewLocalUserTag(user) // want `names.NewLocalUserTag may panic on user: check it with names.IsValidUserName first`
+	if names.IsValidUserName(user) {
+		names.NewLocalUserTag(user)
+	}
+}
+
+func mismatchedGuard(id string) {
+	if names.IsValidMachine(id) {
+		names.NewUnitTag(id) // want `names.NewUnitTag may panic on id: check it with names.IsValidUnit first`
+	}
+}
+
+func guardedForms(name string, ok bool) {
+	if ok && names.IsValidUnit(name) {
+		names.NewUnitTag(name)
+	}
+	if !names.IsValidUnit(name) {
+		println("invalid")
+	} else {
+		names.NewUnitTag(name)
+	}
+	for {
+		if !ok || !names.IsValidUnit(name) {
+			break
+		}
+		names.NewUnitTag(name)
+	}
+	if !names.IsValidUnit(name) {
+		panic("invalid")
+	}
+	names.NewUnitTag(name)
+}
+
+func unguardedForms(name, other string, ok bool) {
+	if !names.IsValidUnit(name) {
+		println("invalid")
+	}
+	names.NewUnitTag(name) // want `names.NewUnitTag may panic on name`
+
+	if names.IsValidUnit(other) {
+		println("valid")
+	} else {
+		names.NewUnitTag(other) // want `names.NewUnitTag may panic on other`
+	}
+
+	if ok || names.IsValidUnit(other) {
+		names.NewUnitTag(other) // want `names.NewUnitTag may panic on other`
+	}
+}
+
+func siblingBranch(name string, ok bool) {
+	switch {
+	case ok:
+		if !names.IsValidUnit(name) {
+			return
+		}
+	default:
+		names.NewUnitTag(name) // want `names.NewUnitTag may panic on name`
+	}
+}
+
+func reassigned(name string) {
+	if !names.IsValidUnit(name) {
+		return
+	}
+	name += "x"
+	names.NewUnitTag(name) // want `names.NewUnitTag may panic on name`
+}
+
+func neverPanics(name, id string) {
+	names.NewApplicationTag(name)
+	names.NewMachineTag(id)
+}
+
+func byHand(name, id string) {
+	_ = "unit-" + name                // want `tag "unit-..." built by hand`
+	_ = fmt.Sprintf("machine-%s", id) // want `tag "machine-%s" built by hand`
+	_ = "service-" + name             // want `tag "service-..." built by hand`
+	_ = "not-a-tag-" + name
+	_ = fmt.Sprintf("unit %s", name)
+}
+
+func assert(s string) {
+	tag, err := names.ParseTag(s)
+	if err != nil {
+		return
+	}
+	_ = tag.(names.UnitTag) // want `type assertion on the result of names.ParseTag: use names.ParseUnitTag`
+	_ = tag.(names.MachineTag)
+	switch tag.(type) {
+	case names.UnitTag:
+	}
+}
+
+func printing(tag names.Tag, unit names.UnitTag, w io.Writer) {
+	fmt.Println(unit.Id())
+	fmt.Println(unit.String())            // want `tag unit.String\(\) printed by fmt.Println`
+	fmt.Printf("%s\n", tag)               // want `tag tag printed by fmt.Printf`
+	fmt.Fprintln(os.Stderr, "unit", unit) // want `tag unit printed by fmt.Fprintln`
+	fmt.Fprintf(os.Stdout, "%v\n", tag)   // want `tag tag printed by fmt.Fprintf`
+	fmt.Fprintln(w, unit)
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, unit.String())
+	_ = fmt.Sprintf("%s", unit)
+	_ = fmt.Errorf("%s", unit)
+}
@@ -0,0 +1,48 @@
+// Package names is a stand-in for the names package, holding just
+// enough of its API for the analyzer tests.
+package names
+
+import "fmt"
+
+type Tag interface {
+	Kind() string
+	Id() string
+	fmt.Stringer
+}
+
+type UnitTag struct{ name string }
+
+func (t UnitTag) Kind() string   { return "unit" }
+func (t UnitTag) Id() string     { return t.name }
+func (t UnitTag) String() string { return "unit-" + t.name }
+
+type MachineTag struct{ id string }
+
+func (t MachineTag) Kind() string   { return "machine" }
+func (t MachineTag) Id() string     { return t.id }
+func (t MachineTag) String() string { return "machine-" + t.id }
+
+type UserTag struct{ name string }
+
+func (t UserTag) Kind() string   { return "user" }
+func (t UserTag) Id() string     { return t.name }
+func (t UserTag) String() string { return "user-" + t.name }
+
+type ApplicationTag struct{ name string }
+
+func (t ApplicationTag) Kind() string   { return "application" }
+func (t ApplicationTag) Id() string     { return t.name }
+func (t ApplicationTag) String() string { return "application-" + t.name }
+
+func NewUnitTag(name string) UnitTag             { return UnitTag{name} }
+func TryNewUnitTag(name string) (UnitTag, error) { return UnitTag{name}, nil }
+func IsValidUnit(name string) bool               { return true }
+func ParseUnitTag(s string) (UnitTag, error)     { return UnitTag{}, nil }
+func NewMachineTag(id string) MachineTag         { return MachineTag{id} }
+func IsValidMachine(id string) bool              { return true }
+func NewLocalUserTag(name string) UserTag        { return UserTag{name} }
+func IsValidUserName(name string) bool           { return true }
+func NewApplicationTag(name string) ApplicationTag {
+	return ApplicationTag{name}
+}
+func ParseTag(s string) (Tag, error) { return nil, nil }
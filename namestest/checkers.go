@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namestest
+
+import (
+	"fmt"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type tagEqualsChecker struct {
+	*gc.CheckerInfo
+}
+
+// TagEquals is a checker that compares two tags by kind and id, so that
+// tags of the same kind made in different ways compare equal, and a
+// mismatch is reported using the tags' string forms.
+//
+// For example:
+//
+//	c.Assert(tag, namestest.TagEquals, names.NewUnitTag("wordpress/0"))
+var TagEquals gc.Checker = &tagEqualsChecker{
+	&gc.CheckerInfo{Name: "TagEquals", Params: []string{"obtained", "expected"}},
+}
+
+func (checker *tagEqualsChecker) Check(params []interface{}, _ []string) (bool, string) {
+	obtained, ok := params[0].(names.Tag)
+	if !ok && params[0] != nil {
+		return false, fmt.Sprintf("obtained value is not a tag: %#v", params[0])
+	}
+	expected, ok := params[1].(names.Tag)
+	if !ok && params[1] != nil {
+		return false, fmt.Sprintf("expected value is not a tag: %#v", params[1])
+	}
+	if obtained == nil || expected == nil {
+		if obtained == nil && expected == nil {
+			return true, ""
+		}
+		return false, fmt.Sprintf("obtained %s, expected %s", tagString(obtained), tagString(expected))
+	}
+	if obtained.Kind() == expected.Kind() && obtained.Id() == expected.Id() {
+		return true, ""
+	}
+	return false, fmt.Sprintf("obtained %s, expected %s", tagString(obtained), tagString(expected))
+}
+
+func tagString(tag names.Tag) string {
+	if tag == nil {
+		return "nil tag"
+	}
+	return fmt.Sprintf("%q", tag.String())
+}
+
+type setEqualsChecker struct {
+	*gc.CheckerInfo
+}
+
+// SetEquals is a checker that compares the members of a names.Set with
+// those of another set or of a []names.Tag. Unlike gc.DeepEquals it
+// ignores entries that map to false and treats nil and empty sets as
+// equal, and a mismatch is reported as the sorted tags that are missing
+// and unexpected.
+//
+// For example:
+//
+//	c.Assert(set, namestest.SetEquals, []names.Tag{unit, machine})
+var SetEquals gc.Checker = &setEqualsChecker{
+	&gc.CheckerInfo{Name: "SetEquals", Params: []string{"obtained", "expected"}},
+}
+
+func (checker *setEqualsChecker) Check(params []interface{}, _ []string) (bool, string) {
+	obtained, err := toSet(params[0])
+	if err != nil {
+		return false, "obtained " + err.Error()
+	}
+	expected, err := toSet(params[1])
+	if err != nil {
+		return false, "expected " + err.Error()
+	}
+	missing := expected.Difference(obtained)
+	unexpected := obtained.Difference(expected)
+	if missing.IsEmpty() && unexpected.IsEmpty() {
+		return true, ""
+	}
+	var errs []string
+	if !missing.IsEmpty() {
+		errs = append(errs, "missing: "+joinTags(missing))
+	}
+	if !unexpected.IsEmpty() {
+		errs = append(errs, "unexpected: "+joinTags(unexpected))
+	}
+	return false, strings.Join(errs, "; ")
+}
+
+// toSet returns a set holding the tags in value, which may be a
+// names.Set or a []names.Tag.
+func toSet(value interface{}) (names.Set, error) {
+	set := names.NewSet()
+	switch value := value.(type) {
+	case nil:
+	case names.Set:
+		for tag, ok := range value {
+			if ok {
+				set.Add(tag)
+			}
+		}
+	case []names.Tag:
+		for _, tag := range value {
+			set.Add(tag)
+		}
+	default:
+		return nil, fmt.Errorf("value is not a names.Set or []names.Tag: %#v", value)
+	}
+	return set, nil
+}
+
+func joinTags(set names.Set) string {
+	values := set.SortedValues()
+	result := make([]string, len(values))
+	for i, tag := range values {
+		result[i] = tag.String()
+	}
+	return strings.Join(result, ", ")
+}
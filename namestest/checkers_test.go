@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namestest_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/names/v6/namestest"
+)
+
+type checkersSuite struct{}
+
+var _ = gc.Suite(&checkersSuite{})
+
+var (
+	wordpress0 = names.NewUnitTag("wordpress/0")
+	wordpress1 = names.NewUnitTag("wordpress/1")
+	machine0   = names.NewMachineTag("0")
+)
+
+var tagEqualsTests = []struct {
+	obtained interface{}
+	expected interface{}
+	result   bool
+	error    string
+}{
+	{obtained: wordpress0, expected: names.NewUnitTag("wordpress/0"), result: true},
+	{obtained: nil, expected: nil, result: true},
+	{obtained: names.MachineTag{}, expected: names.NewMachineTag(""), result: true},
+	{obtained: wordpress0, expected: wordpress1, error: `obtained "unit-wordpress-0", expected "unit-wordpress-1"`},
+	{obtained: machine0, expected: names.NewControllerAgentTag("0"), error: `obtained "machine-0", expected "controller-0"`},
+	{obtained: nil, expected: machine0, error: `obtained nil tag, expected "machine-0"`},
+	{obtained: "unit-wordpress-0", expected: wordpress0, error: `obtained value is not a tag: "unit-wordpress-0"`},
+	{obtained: wordpress0, expected: 42, error: `expected value is not a tag: 42`},
+}
+
+func (s *checkersSuite) TestTagEquals(c *gc.C) {
+	for i, t := range tagEqualsTests {
+		c.Logf("test %d: %v %v", i, t.obtained, t.expected)
+		result, err := namestest.TagEquals.Check([]interface{}{t.obtained, t.expected}, nil)
+		c.Check(result, gc.Equals, t.result)
+		c.Check(err, gc.Equals, t.error)
+	}
+}
+
+var setEqualsTests = []struct {
+	obtained interface{}
+	expected interface{}
+	result   bool
+	error    string
+}{
+	{obtained: names.NewSet(wordpress0, machine0), expected: names.NewSet(machine0, wordpress0), result: true},
+	{obtained: names.NewSet(wordpress0, machine0), expected: []names.Tag{machine0, wordpress0}, result: true},
+	{obtained: names.Set{wordpress0: true, machine0: false}, expected: []names.Tag{wordpress0}, result: true},
+	{obtained: names.Set(nil), expected: names.NewSet(), result: true},
+	{obtained: nil, expected: []names.Tag{}, result: true},
+	{
+		obtained: names.NewSet(wordpress0, machine0),
+		expected: []names.Tag{wordpress1, wordpress0},
+		error:    `missing: unit-wordpress-1; unexpected: machine-0`,
+	},
+	{obtained: names.NewSet(), expected: []names.Tag{wordpress0}, error: `missing: unit-wordpress-0`},
+	{obtained: []string{"machine-0"}, expected: names.NewSet(), error: `obtained value is not a names.Set or \[\]names.Tag: .*`},
+}
+
+func (s *checkersSuite) TestSetEquals(c *gc.C) {
+	for i, t := range setEqualsTests {
+		c.Logf("test %d: %v %v", i, t.obtained, t.expected)
+		result, err := namestest.SetEquals.Check([]interface{}{t.obtained, t.expected}, nil)
+		c.Check(result, gc.Equals, t.result)
+		c.Check(err, gc.Matches, t.error)
+	}
+}
+
+func (s *checkersSuite) TestCheckersInSuite(c *gc.C) {
+	c.Assert(names.NewUnitTag("mysql/3"), namestest.TagEquals, names.NewUnitTag("mysql/3"))
+	c.Assert(names.NewSet(wordpress0), namestest.SetEquals, []names.Tag{wordpress0})
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namestest provides helpers for testing code that uses tags:
+// random generators of valid tags and gocheck checkers for comparing
+// tags and sets of tags.
+package namestest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/juju/names/v6"
+)
+
+// DefaultSize is the size used by generators created by NewGenerator.
+const DefaultSize = 4
+
+// maxAttempts bounds the number of candidates generated for a tag before
+// giving up. Only kinds with checks beyond their grammar, such as length
+// limits, should ever need more than one.
+const maxAttempts = 100
+
+// Generator produces random valid tags from the grammars described by
+// names.Kinds. Generation is deterministic for a given seed and size,
+// and a smaller size produces shorter ids, so a failing property can be
+// re-run with the same seed and decreasing sizes to find a minimal
+// counter-example.
+type Generator struct {
+	rand *rand.Rand
+	size int
+}
+
+// NewGenerator returns a generator seeded with seed, of DefaultSize.
+func NewGenerator(seed int64) *Generator {
+	return &Generator{
+		rand: rand.New(rand.NewSource(seed)),
+		size: DefaultSize,
+	}
+}
+
+// WithSize returns a generator that shares g's source of randomness, but
+// repeats each part of a grammar at most size extra times. A size of 0
+// produces the shortest ids each grammar allows, and no hand-picked edge
+// cases.
+func (g *Generator) WithSize(size int) *Generator {
+	if size < 0 {
+		size = 0
+	}
+	return &Generator{rand: g.rand, size: size}
+}
+
+// Generate returns a random valid tag of type T. It panics if T is not
+// a kind described by names.Kinds.
+func Generate[T names.Tag](g *Generator) T {
+	info := kindOf(reflect.TypeOf((*T)(nil)).Elem())
+	tag, err := g.generate(info)
+	if err != nil {
+		panic(err.Error())
+	}
+	return tag.(T)
+}
+
+// Tag returns a random valid tag of a random kind.
+func (g *Generator) Tag() names.Tag {
+	kinds := names.Kinds()
+	tag, err := g.generate(kinds[g.rand.Intn(len(kinds))])
+	if err != nil {
+		panic(err.Error())
+	}
+	return tag
+}
+
+// Set returns a set of up to n random valid tags of random kinds.
+func (g *Generator) Set(n int) names.Set {
+	set := names.NewSet()
+	for i := 0; i < n; i++ {
+		set.Add(g.Tag())
+	}
+	return set
+}
+
+func (g *Generator) generate(info names.KindInfo) (names.Tag, error) {
+	// Edge cases are hand-picked, so mix them in now and again rather
+	// than hoping the grammar happens to produce them. They are not
+	// minimal, so are left out at size 0.
+	if edges := edgeCases[info.Type]; len(edges) > 0 && g.size > 0 && g.rand.Intn(4) == 0 {
+		return names.ParseTag(edges[g.rand.Intn(len(edges))])
+	}
+	re, err := syntax.Parse(info.TagPattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < maxAttempts; i++ {
+		var b strings.Builder
+		g.write(&b, re)
+		tag, err := names.ParseTag(info.Kind + "-" + b.String())
+		if err == nil && reflect.TypeOf(tag) == info.Type {
+			return tag, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot generate a valid %s tag", info.Kind)
+}
+
+// write appends a random string matching re to b.
+func (g *Generator) write(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		// Pick a range, then a rune in it, so that small ranges such
+		// as "-" and "." are not swamped by letters and digits.
+		i := 2 * g.rand.Intn(len(re.Rune)/2)
+		lo, hi := re.Rune[i], re.Rune[i+1]
+		b.WriteRune(lo + rune(g.rand.Intn(int(hi-lo)+1)))
+	case syntax.OpCapture:
+		g.write(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.write(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.write(b, re.Sub[g.rand.Intn(len(re.Sub))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+		extra := g.size
+		if max >= 0 && max-min < extra {
+			extra = max - min
+		}
+		n := min + g.rand.Intn(extra+1)
+		for i := 0; i < n; i++ {
+			g.write(b, re.Sub[0])
+		}
+	}
+}
+
+func kindOf(t reflect.Type) names.KindInfo {
+	for _, info := range names.Kinds() {
+		if info.Type == t {
+			return info
+		}
+	}
+	panic(fmt.Sprintf("%v is not a known tag type", t))
+}
+
+// edgeCases holds tags that exercise the corners of each grammar, keyed
+// by tag type.
+var edgeCases = map[reflect.Type][]string{
+	reflect.TypeOf(names.ApplicationTag{}): {
+		"application-a", "application-mysql-k8s", "application-a1-b2-c3",
+	},
+	reflect.TypeOf(names.UnitTag{}): {
+		"unit-a-0", "unit-mysql-k8s-10", "unit-a1-b2-c3-123",
+	},
+	reflect.TypeOf(names.MachineTag{}): {
+		"machine-0", "machine-10-lxd-0", "machine-0-lxd-1-kvm-2",
+	},
+	reflect.TypeOf(names.CloudCredentialTag{}): {
+		"cloudcred-manual%5fcloud_bob_foo%5fbar",
+		"cloudcred-aws_bob@external_foo@somewhere.com",
+		"cloudcred-google_bob+bob@remote_a%5fb+c@d",
+	},
+	reflect.TypeOf(names.RelationTag{}): {
+		"relation-riak.ring", "relation-mysql-k8s.db-admin#wordpress.db_admin",
+	},
+	reflect.TypeOf(names.FilesystemTag{}): {
+		"filesystem-0", "filesystem-0-lxd-1-2", "filesystem-mysql-k8s-0-3",
+	},
+	reflect.TypeOf(names.VolumeTag{}): {
+		"volume-0", "volume-0-lxd-1-2", "volume-mysql-k8s-0-3",
+	},
+	reflect.TypeOf(names.UserTag{}): {
+		"user-bob", "user-bob@external", "user-b.o+b@my-domain.com",
+	},
+}
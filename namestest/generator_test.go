@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namestest_test
+
+import (
+	"reflect"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/names/v6/namestest"
+)
+
+type generatorSuite struct{}
+
+var _ = gc.Suite(&generatorSuite{})
+
+func (s *generatorSuite) TestGenerateEveryKind(c *gc.C) {
+	g := namestest.NewGenerator(1)
+	seen := make(map[reflect.Type]bool)
+	for i := 0; i < 2000; i++ {
+		tag := g.Tag()
+		seen[reflect.TypeOf(tag)] = true
+
+		parsed, err := names.ParseTag(tag.String())
+		c.Assert(err, gc.IsNil, gc.Commentf("%q", tag.String()))
+		c.Assert(parsed, namestest.TagEquals, tag)
+	}
+	for _, info := range names.Kinds() {
+		c.Check(seen[info.Type], gc.Equals, true, gc.Commentf("%v", info.Type))
+	}
+}
+
+func (s *generatorSuite) TestGenerateTyped(c *gc.C) {
+	g := namestest.NewGenerator(2)
+	for i := 0; i < 200; i++ {
+		unit := namestest.Generate[names.UnitTag](g)
+		c.Assert(names.IsValidUnit(unit.Id()), gc.Equals, true)
+
+		machine := namestest.Generate[names.MachineTag](g)
+		c.Assert(names.IsValidMachine(machine.Id()), gc.Equals, true)
+
+		cred := namestest.Generate[names.CloudCredentialTag](g)
+		c.Assert(names.IsValidCloudCredential(cred.Id()), gc.Equals, true)
+
+		device := namestest.Generate[names.LinkLayerDeviceTag](g)
+		c.Assert(names.IsValidLinkLayerDevice(device.Id()), gc.Equals, true)
+
+		agent := namestest.Generate[names.ControllerAgentTag](g)
+		c.Assert(names.IsValidControllerAgent(agent.Id()), gc.Equals, true)
+	}
+}
+
+func (s *generatorSuite) TestGenerateUnknownType(c *gc.C) {
+	g := namestest.NewGenerator(3)
+	c.Assert(func() { namestest.Generate[names.Tag](g) }, gc.PanicMatches, `names.Tag is not a known tag type`)
+}
+
+func (s *generatorSuite) TestDeterministic(c *gc.C) {
+	g1 := namestest.NewGenerator(42)
+	g2 := namestest.NewGenerator(42)
+	for i := 0; i < 100; i++ {
+		c.Assert(g1.Tag(), gc.Equals, g2.Tag())
+	}
+}
+
+func (s *generatorSuite) TestSizeZeroIsMinimal(c *gc.C) {
+	g := namestest.NewGenerator(4).WithSize(0)
+	for i := 0; i < 100; i++ {
+		machine := namestest.Generate[names.MachineTag](g)
+		c.Assert(machine.ContainerType(), gc.Equals, "", gc.Commentf("%q", machine.Id()))
+	}
+}
+
+func (s *generatorSuite) TestCoversEdgeCases(c *gc.C) {
+	g := namestest.NewGenerator(5)
+	var nested, hyphenated, underscored bool
+	for i := 0; i < 500; i++ {
+		nested = nested || namestest.Generate[names.MachineTag](g).ContainerType() != ""
+		hyphenated = hyphenated || strings.Contains(namestest.Generate[names.ApplicationTag](g).Id(), "-")
+		underscored = underscored || strings.Contains(namestest.Generate[names.CloudCredentialTag](g).Name(), "_")
+	}
+	c.Check(nested, gc.Equals, true)
+	c.Check(hyphenated, gc.Equals, true)
+	c.Check(underscored, gc.Equals, true)
+}
+
+func (s *generatorSuite) TestSet(c *gc.C) {
+	set := namestest.NewGenerator(6).Set(10)
+	c.Assert(set.Size() > 0 && set.Size() <= 10, gc.Equals, true)
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namestest_test
+
+import (
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
@@ -6,6 +6,7 @@ package names
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 const OperationTagKind = "operation"
@@ -31,15 +32,7 @@ func NewOperationTag(id string) OperationTag {
 
 // ParseOperationTag parses an operation tag string.
 func ParseOperationTag(operationTag string) (OperationTag, error) {
-	tag, err := ParseTag(operationTag)
-	if err != nil {
-		return OperationTag{}, err
-	}
-	at, ok := tag.(OperationTag)
-	if !ok {
-		return OperationTag{}, invalidTagError(operationTag, OperationTagKind)
-	}
-	return at, nil
+	return ParseAs[OperationTag](operationTag)
 }
 
 func (t OperationTag) String() string { return t.Kind() + "-" + t.Id() }
@@ -50,3 +43,67 @@ func (t OperationTag) Id() string     { return t.ID }
 func IsValidOperation(id string) bool {
 	return validOperation.MatchString(id)
 }
+
+// OperationTask links an action, or task, to the operation it was
+// enqueued as part of. Only actions v2 belong to operations.
+//
+// The string form is "<operation id>/<action id>", for example "7/12".
+type OperationTask struct {
+	Operation OperationTag
+	Action    ActionTag
+}
+
+// NewOperationTask returns the link between the given operation and
+// action. It returns an error if the action is not an actions v2 tag.
+func NewOperationTask(operation OperationTag, action ActionTag) (OperationTask, error) {
+	if !IsValidOperation(operation.Id()) {
+		return OperationTask{}, fmt.Errorf("invalid operation id %q", operation.Id())
+	}
+	if !IsValidActionV2(action.Id()) {
+		return OperationTask{}, fmt.Errorf("invalid operation task action id %q", action.Id())
+	}
+	return OperationTask{Operation: operation, Action: action}, nil
+}
+
+// ParseOperationTask parses the string form of an operation task, as
+// produced by OperationTask.String.
+func ParseOperationTask(s string) (OperationTask, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || !IsValidOperation(parts[0]) || !IsValidActionV2(parts[1]) {
+		return OperationTask{}, fmt.Errorf("%q is not a valid operation task", s)
+	}
+	return OperationTask{
+		Operation: NewOperationTag(parts[0]),
+		Action:    NewActionTag(parts[1]),
+	}, nil
+}
+
+// String returns the canonical string form of the operation task.
+func (t OperationTask) String() string {
+	return t.Operation.Id() + "/" + t.Action.Id()
+}
+
+// GroupTasksByOperation returns the actions of the given tasks keyed by
+// the operation they belong to, preserving the order of the tasks.
+func GroupTasksByOperation(tasks []OperationTask) map[OperationTag][]ActionTag {
+	result := make(map[OperationTag][]ActionTag)
+	for _, task := range tasks {
+		result[task.Operation] = append(result[task.Operation], task.Action)
+	}
+	return result
+}
+
+// TryNewOperationTag returns the tag for the given id, or an error if id is
+// not a valid operation id.
+func TryNewOperationTag(id string) (OperationTag, error) {
+	if !IsValidOperation(id) {
+		return OperationTag{}, fmt.Errorf("invalid operation id %q", id)
+	}
+	return NewOperationTag(id), nil
+}
+
+// MustNewOperationTag returns the tag for the given id.
+// It will panic if id is not a valid operation id.
+func MustNewOperationTag(id string) OperationTag {
+	return mustTag(TryNewOperationTag(id))
+}
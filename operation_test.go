@@ -43,3 +43,59 @@ func (s *operationSuite) TestString(c *gc.C) {
 	tag := names.NewOperationTag("666")
 	c.Assert(tag.String(), gc.Equals, "operation-666")
 }
+
+func (s *operationSuite) TestNewOperationTask(c *gc.C) {
+	task, err := names.NewOperationTask(names.NewOperationTag("7"), names.NewActionTag("12"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(task.String(), gc.Equals, "7/12")
+
+	_, err = names.NewOperationTask(names.NewOperationTag("7"), names.NewActionTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+	c.Assert(err, gc.ErrorMatches, `invalid operation task action id "f47ac10b-58cc-4372-a567-0e02b2c3d479"`)
+
+	_, err = names.NewOperationTask(names.OperationTag{}, names.NewActionTag("12"))
+	c.Assert(err, gc.ErrorMatches, `invalid operation id ""`)
+}
+
+var parseOperationTaskTests = []struct {
+	task      string
+	operation string
+	action    string
+	err       string
+}{
+	{task: "7/12", operation: "7", action: "12"},
+	{task: "0/0", operation: "0", action: "0"},
+	{task: "", err: `"" is not a valid operation task`},
+	{task: "7", err: `"7" is not a valid operation task`},
+	{task: "7/12/1", err: `"7/12/1" is not a valid operation task`},
+	{task: "7/f47ac10b-58cc-4372-a567-0e02b2c3d479", err: `"7/f47ac10b-58cc-4372-a567-0e02b2c3d479" is not a valid operation task`},
+	{task: "operation-7/action-12", err: `"operation-7/action-12" is not a valid operation task`},
+}
+
+func (s *operationSuite) TestParseOperationTask(c *gc.C) {
+	for i, test := range parseOperationTaskTests {
+		c.Logf("test %d: %q", i, test.task)
+		task, err := names.ParseOperationTask(test.task)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(task.Operation, gc.Equals, names.NewOperationTag(test.operation))
+		c.Check(task.Action, gc.Equals, names.NewActionTag(test.action))
+		c.Check(task.String(), gc.Equals, test.task)
+	}
+}
+
+func (s *operationSuite) TestGroupTasksByOperation(c *gc.C) {
+	var tasks []names.OperationTask
+	for _, t := range []string{"1/1", "2/3", "1/2", "2/4", "3/5"} {
+		task, err := names.ParseOperationTask(t)
+		c.Assert(err, jc.ErrorIsNil)
+		tasks = append(tasks, task)
+	}
+	c.Assert(names.GroupTasksByOperation(tasks), gc.DeepEquals, map[names.OperationTag][]names.ActionTag{
+		names.NewOperationTag("1"): {names.NewActionTag("1"), names.NewActionTag("2")},
+		names.NewOperationTag("2"): {names.NewActionTag("3"), names.NewActionTag("4")},
+		names.NewOperationTag("3"): {names.NewActionTag("5")},
+	})
+}
@@ -4,6 +4,7 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
 
 	"github.com/juju/utils/v3"
@@ -46,15 +47,7 @@ func NewPayloadTag(id string) PayloadTag {
 // ParsePayloadTag parses a payload tag string.
 // So ParsePayloadTag(tag.String()) === tag.
 func ParsePayloadTag(tag string) (PayloadTag, error) {
-	t, err := ParseTag(tag)
-	if err != nil {
-		return PayloadTag{}, err
-	}
-	pt, ok := t.(PayloadTag)
-	if !ok {
-		return PayloadTag{}, invalidTagError(tag, PayloadTagKind)
-	}
-	return pt, nil
+	return ParseAs[PayloadTag](tag)
 }
 
 // Kind implements Tag.
@@ -72,3 +65,18 @@ func (t PayloadTag) Id() string {
 func (t PayloadTag) String() string {
 	return tagString(t)
 }
+
+// TryNewPayloadTag returns the tag for the given id, or an error if id is
+// not a valid payload id.
+func TryNewPayloadTag(id string) (PayloadTag, error) {
+	if !isValidPayload(id) {
+		return PayloadTag{}, fmt.Errorf("%q is not a valid payload id", id)
+	}
+	return NewPayloadTag(id), nil
+}
+
+// MustNewPayloadTag returns the tag for the given id.
+// It will panic if id is not a valid payload id.
+func MustNewPayloadTag(id string) PayloadTag {
+	return mustTag(TryNewPayloadTag(id))
+}
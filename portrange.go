@@ -0,0 +1,162 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// TCPProtocol is the protocol of TCP port ranges.
+	TCPProtocol = "tcp"
+	// UDPProtocol is the protocol of UDP port ranges.
+	UDPProtocol = "udp"
+	// ICMPProtocol is the protocol of ICMP port ranges, which have
+	// no ports.
+	ICMPProtocol = "icmp"
+
+	maxPort = 65535
+)
+
+var validPortRange = regexp.MustCompile(
+	"^(" + ApplicationSnippet + "/" + NumberSnippet + ")(?::(" + RelationSnippet + "))? " +
+		"(?:(?:(" + NumberSnippet + ")(?:-(" + NumberSnippet + "))?/([a-zA-Z]+))|([iI][cC][mM][pP]))$",
+)
+
+// PortRange is a range of ports opened by a unit, either on a single
+// endpoint or, if Endpoint is empty, on all of the unit's endpoints.
+//
+// The string form is "<unit>[:<endpoint>] <from>[-<to>]/<protocol>",
+// for example "mysql/0:db 3306/tcp" or "nginx/1 8000-8080/udp". ICMP
+// ranges have no ports and are written "<unit>[:<endpoint>] icmp".
+type PortRange struct {
+	Unit     UnitTag
+	Endpoint string
+	Protocol string
+	FromPort int
+	ToPort   int
+}
+
+// Validate returns an error if the port range is not valid.
+func (r PortRange) Validate() error {
+	if r.Unit == (UnitTag{}) {
+		return fmt.Errorf("port range missing unit")
+	}
+	if r.Endpoint != "" && !validRelationName.MatchString(r.Endpoint) {
+		return fmt.Errorf("invalid port range endpoint %q", r.Endpoint)
+	}
+	switch r.Protocol {
+	case ICMPProtocol:
+		if r.FromPort != 0 || r.ToPort != 0 {
+			return fmt.Errorf("icmp port range must not specify ports")
+		}
+		return nil
+	case TCPProtocol, UDPProtocol:
+	default:
+		return fmt.Errorf("invalid port range protocol %q", r.Protocol)
+	}
+	if r.FromPort < 1 || r.FromPort > maxPort || r.ToPort < 1 || r.ToPort > maxPort {
+		return fmt.Errorf("port range %d-%d out of bounds (1-%d)", r.FromPort, r.ToPort, maxPort)
+	}
+	if r.FromPort > r.ToPort {
+		return fmt.Errorf("invalid port range %d-%d", r.FromPort, r.ToPort)
+	}
+	return nil
+}
+
+// String returns the canonical string form of the port range.
+func (r PortRange) String() string {
+	s := r.Unit.Id()
+	if r.Endpoint != "" {
+		s += ":" + r.Endpoint
+	}
+	switch {
+	case r.Protocol == ICMPProtocol:
+		return s + " " + ICMPProtocol
+	case r.FromPort == r.ToPort:
+		return fmt.Sprintf("%s %d/%s", s, r.FromPort, r.Protocol)
+	}
+	return fmt.Sprintf("%s %d-%d/%s", s, r.FromPort, r.ToPort, r.Protocol)
+}
+
+// ParsePortRange parses the string form of a port range. Protocols
+// are accepted in any case, and a single port is read as a range
+// of one.
+func ParsePortRange(s string) (PortRange, error) {
+	parts := validPortRange.FindStringSubmatch(s)
+	if parts == nil {
+		return PortRange{}, fmt.Errorf("%q is not a valid port range", s)
+	}
+	r := PortRange{
+		Unit:     NewUnitTag(parts[1]),
+		Endpoint: parts[2],
+	}
+	if parts[6] != "" {
+		r.Protocol = ICMPProtocol
+	} else {
+		r.Protocol = strings.ToLower(parts[5])
+		r.FromPort, _ = strconv.Atoi(parts[3])
+		r.ToPort = r.FromPort
+		if parts[4] != "" {
+			r.ToPort, _ = strconv.Atoi(parts[4])
+		}
+	}
+	if err := r.Validate(); err != nil {
+		return PortRange{}, fmt.Errorf("%q is not a valid port range: %v", s, err)
+	}
+	return r, nil
+}
+
+// Overlaps reports whether the two port ranges are for the same unit,
+// endpoint and protocol and share at least one port.
+func (r PortRange) Overlaps(other PortRange) bool {
+	if r.Unit != other.Unit || r.Endpoint != other.Endpoint || r.Protocol != other.Protocol {
+		return false
+	}
+	return r.FromPort <= other.ToPort && other.FromPort <= r.ToPort
+}
+
+// MergePortRanges returns the given port ranges with overlapping and
+// adjacent ranges for the same unit, endpoint and protocol merged.
+// The result is sorted by unit, endpoint, protocol and first port.
+func MergePortRanges(ranges []PortRange) []PortRange {
+	sorted := make([]PortRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Unit != b.Unit {
+			return a.Unit.String() < b.Unit.String()
+		}
+		if a.Endpoint != b.Endpoint {
+			return a.Endpoint < b.Endpoint
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		if a.FromPort != b.FromPort {
+			return a.FromPort < b.FromPort
+		}
+		return a.ToPort < b.ToPort
+	})
+
+	var result []PortRange
+	for _, r := range sorted {
+		if n := len(result); n > 0 {
+			last := &result[n-1]
+			if last.Unit == r.Unit && last.Endpoint == r.Endpoint && last.Protocol == r.Protocol &&
+				r.FromPort <= last.ToPort+1 {
+				if r.ToPort > last.ToPort {
+					last.ToPort = r.ToPort
+				}
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type portRangeSuite struct{}
+
+var _ = gc.Suite(&portRangeSuite{})
+
+var parsePortRangeTests = []struct {
+	portRange string
+	expected  names.PortRange
+	canonical string
+	err       string
+}{{
+	portRange: "mysql/0:db 3306/tcp",
+	expected:  names.PortRange{Unit: names.NewUnitTag("mysql/0"), Endpoint: "db", Protocol: "tcp", FromPort: 3306, ToPort: 3306},
+}, {
+	portRange: "nginx-ingress/1 8000-8080/udp",
+	expected:  names.PortRange{Unit: names.NewUnitTag("nginx-ingress/1"), Protocol: "udp", FromPort: 8000, ToPort: 8080},
+}, {
+	portRange: "nginx/1:website 80-80/TCP",
+	expected:  names.PortRange{Unit: names.NewUnitTag("nginx/1"), Endpoint: "website", Protocol: "tcp", FromPort: 80, ToPort: 80},
+	canonical: "nginx/1:website 80/tcp",
+}, {
+	portRange: "nginx/1 ICMP",
+	expected:  names.PortRange{Unit: names.NewUnitTag("nginx/1"), Protocol: "icmp"},
+	canonical: "nginx/1 icmp",
+}, {
+	portRange: "nginx/1 80",
+	err:       `"nginx/1 80" is not a valid port range`,
+}, {
+	portRange: "nginx 80/tcp",
+	err:       `"nginx 80/tcp" is not a valid port range`,
+}, {
+	portRange: "nginx/1 80/sctp",
+	err:       `"nginx/1 80/sctp" is not a valid port range: invalid port range protocol "sctp"`,
+}, {
+	portRange: "nginx/1 90-80/tcp",
+	err:       `"nginx/1 90-80/tcp" is not a valid port range: invalid port range 90-80`,
+}, {
+	portRange: "nginx/1 0/tcp",
+	err:       `"nginx/1 0/tcp" is not a valid port range: port range 0-0 out of bounds \(1-65535\)`,
+}, {
+	portRange: "nginx/1 80-65536/tcp",
+	err:       `"nginx/1 80-65536/tcp" is not a valid port range: port range 80-65536 out of bounds \(1-65535\)`,
+}}
+
+func (s *portRangeSuite) TestParsePortRange(c *gc.C) {
+	for i, test := range parsePortRangeTests {
+		c.Logf("test %d: %q", i, test.portRange)
+		r, err := names.ParsePortRange(test.portRange)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(r, gc.Equals, test.expected)
+		canonical := test.canonical
+		if canonical == "" {
+			canonical = test.portRange
+		}
+		c.Check(r.String(), gc.Equals, canonical)
+	}
+}
+
+func (s *portRangeSuite) TestValidate(c *gc.C) {
+	unit := names.NewUnitTag("mysql/0")
+	c.Assert(names.PortRange{Protocol: "tcp", FromPort: 1, ToPort: 1}.Validate(), gc.ErrorMatches, "port range missing unit")
+	c.Assert(names.PortRange{Unit: unit, Endpoint: "Db", Protocol: "tcp", FromPort: 1, ToPort: 1}.Validate(), gc.ErrorMatches, `invalid port range endpoint "Db"`)
+	c.Assert(names.PortRange{Unit: unit, Protocol: "icmp", FromPort: 1, ToPort: 1}.Validate(), gc.ErrorMatches, "icmp port range must not specify ports")
+	c.Assert(names.PortRange{Unit: unit, Protocol: "udp", FromPort: 53, ToPort: 53}.Validate(), jc.ErrorIsNil)
+}
+
+func (s *portRangeSuite) TestOverlaps(c *gc.C) {
+	parse := func(s string) names.PortRange {
+		r, err := names.ParsePortRange(s)
+		c.Assert(err, jc.ErrorIsNil)
+		return r
+	}
+	c.Check(parse("mysql/0 80-90/tcp").Overlaps(parse("mysql/0 90-100/tcp")), jc.IsTrue)
+	c.Check(parse("mysql/0 80-90/tcp").Overlaps(parse("mysql/0 91-100/tcp")), jc.IsFalse)
+	c.Check(parse("mysql/0 80-90/tcp").Overlaps(parse("mysql/0 85/udp")), jc.IsFalse)
+	c.Check(parse("mysql/0 80-90/tcp").Overlaps(parse("mysql/0:db 85/tcp")), jc.IsFalse)
+	c.Check(parse("mysql/0 80-90/tcp").Overlaps(parse("mysql/1 85/tcp")), jc.IsFalse)
+}
+
+func (s *portRangeSuite) TestMergePortRanges(c *gc.C) {
+	var ranges []names.PortRange
+	for _, str := range []string{
+		"mysql/0 100-110/tcp",
+		"mysql/0 80-90/tcp",
+		"mysql/0 85-95/tcp",
+		"mysql/0 96/tcp",
+		"mysql/0 85/udp",
+		"mysql/0:db 3306/tcp",
+		"mysql/0 icmp",
+		"mysql/0 icmp",
+		"mysql/1 80/tcp",
+	} {
+		r, err := names.ParsePortRange(str)
+		c.Assert(err, jc.ErrorIsNil)
+		ranges = append(ranges, r)
+	}
+	var merged []string
+	for _, r := range names.MergePortRanges(ranges) {
+		merged = append(merged, r.String())
+	}
+	c.Assert(merged, jc.DeepEquals, []string{
+		"mysql/0 icmp",
+		"mysql/0 80-96/tcp",
+		"mysql/0 100-110/tcp",
+		"mysql/0 85/udp",
+		"mysql/0:db 3306/tcp",
+		"mysql/1 80/tcp",
+	})
+}
@@ -18,9 +18,13 @@ const RelationSnippet = "[a-z][a-z0-9]*(?:[_-][a-z0-9]+)*"
 // Relation tags have the format "relation-application1.rel1#application2.rel2".
 // For peer relations, the format is "relation-application.rel"
 
+// endpointSnippet matches an application endpoint, "application:relName".
+const endpointSnippet = ApplicationSnippet + ":" + RelationSnippet
+
 var (
-	validRelation     = regexp.MustCompile("^" + ApplicationSnippet + ":" + RelationSnippet + " " + ApplicationSnippet + ":" + RelationSnippet + "$")
-	validPeerRelation = regexp.MustCompile("^" + ApplicationSnippet + ":" + RelationSnippet + "$")
+	validRelation     = regexp.MustCompile("^" + endpointSnippet + " " + endpointSnippet + "$")
+	validPeerRelation = regexp.MustCompile("^" + endpointSnippet + "$")
+	validRelationName = regexp.MustCompile("^" + RelationSnippet + "$")
 )
 
 // IsValidRelation returns whether key is a valid relation key.
@@ -49,15 +53,7 @@ func NewRelationTag(relationKey string) RelationTag {
 
 // ParseRelationTag parses a relation tag string.
 func ParseRelationTag(relationTag string) (RelationTag, error) {
-	tag, err := ParseTag(relationTag)
-	if err != nil {
-		return RelationTag{}, err
-	}
-	rt, ok := tag.(RelationTag)
-	if !ok {
-		return RelationTag{}, invalidTagError(relationTag, RelationTagKind)
-	}
-	return rt, nil
+	return ParseAs[RelationTag](relationTag)
 }
 
 func relationTagSuffixToKey(s string) string {
@@ -65,3 +61,18 @@ func relationTagSuffixToKey(s string) string {
 	s = strings.Replace(s, ".", ":", 2)
 	return strings.Replace(s, "#", " ", 1)
 }
+
+// TryNewRelationTag returns the tag for the given key, or an error if key is
+// not a valid relation key.
+func TryNewRelationTag(key string) (RelationTag, error) {
+	if !IsValidRelation(key) {
+		return RelationTag{}, fmt.Errorf("%q is not a valid relation key", key)
+	}
+	return NewRelationTag(key), nil
+}
+
+// MustNewRelationTag returns the tag for the given key.
+// It will panic if key is not a valid relation key.
+func MustNewRelationTag(key string) RelationTag {
+	return mustTag(TryNewRelationTag(key))
+}
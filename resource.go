@@ -0,0 +1,157 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ResourceTagKind is used as the prefix for the string representation
+// of resource tags.
+const ResourceTagKind = "resource"
+
+// ResourceNameSnippet is a non-compiled regexp that can be composed with
+// other snippets for validating charm resource names.
+const ResourceNameSnippet = "(?:[a-z][a-z0-9]*(?:[_-][a-z0-9]+)*)"
+
+// Resource ids have the format "application/name" or, for a specific
+// revision, "application/name/revision".
+// Resource tags have the format "resource-application.name" or
+// "resource-application.name.revision". Neither application nor
+// resource names may contain ".", so the tag always round-trips.
+var (
+	validResource = regexp.MustCompile(
+		"^(" + ApplicationSnippet + ")/(" + ResourceNameSnippet + ")(?:/(" + NumberSnippet + "))?$",
+	)
+	validResourceName = regexp.MustCompile("^" + ResourceNameSnippet + "$")
+)
+
+// IsValidResource returns whether id is a valid resource id. The
+// revision, if any, must fit in an int.
+func IsValidResource(id string) bool {
+	return parseResource(id) != nil
+}
+
+// parseResource returns the application, name and revision in id, or
+// nil if id is not valid.
+func parseResource(id string) []string {
+	parts := validResource.FindStringSubmatch(id)
+	if len(parts) != 4 {
+		return nil
+	}
+	if parts[3] != "" {
+		if _, err := strconv.Atoi(parts[3]); err != nil {
+			return nil
+		}
+	}
+	return parts[1:]
+}
+
+// IsValidResourceName returns whether name is a valid resource name,
+// without the application qualifier.
+func IsValidResourceName(name string) bool {
+	return validResourceName.MatchString(name)
+}
+
+// ResourceTag represents a charm resource, a file or OCI image, of an
+// application, optionally at a specific revision.
+type ResourceTag struct {
+	application ApplicationTag
+	name        string
+	revision    string
+}
+
+func (t ResourceTag) Kind() string { return ResourceTagKind }
+
+func (t ResourceTag) String() string {
+	return t.Kind() + "-" + strings.Replace(t.Id(), "/", ".", -1)
+}
+
+// Id implements Tag.Id. It returns the empty string if t is zero.
+func (t ResourceTag) Id() string {
+	if t.name == "" {
+		return ""
+	}
+	id := t.application.Id() + "/" + t.name
+	if t.revision != "" {
+		id += "/" + t.revision
+	}
+	return id
+}
+
+// Application returns the tag of the application the resource
+// belongs to.
+func (t ResourceTag) Application() ApplicationTag { return t.application }
+
+// Name returns the resource name, excluding the application and
+// revision.
+func (t ResourceTag) Name() string { return t.name }
+
+// Revision returns the resource revision, and a boolean indicating
+// whether or not the tag has a revision.
+func (t ResourceTag) Revision() (int, bool) {
+	if t.revision == "" {
+		return 0, false
+	}
+	// Revisions are checked to fit in an int when the tag is made.
+	rev, _ := strconv.Atoi(t.revision)
+	return rev, true
+}
+
+// WithRevision returns a copy of the tag for the given revision.
+// It will panic if revision is negative.
+func (t ResourceTag) WithRevision(revision int) ResourceTag {
+	if revision < 0 {
+		panic(fmt.Sprintf("invalid resource revision %d", revision))
+	}
+	t.revision = strconv.Itoa(revision)
+	return t
+}
+
+// WithoutRevision returns a copy of the tag without a revision.
+func (t ResourceTag) WithoutRevision() ResourceTag {
+	t.revision = ""
+	return t
+}
+
+// NewResourceTag returns the tag for the resource with the given id.
+// It will panic if the given id is not valid.
+func NewResourceTag(id string) ResourceTag {
+	parts := parseResource(id)
+	if parts == nil {
+		panic(fmt.Sprintf("%q is not a valid resource id", id))
+	}
+	return ResourceTag{
+		application: NewApplicationTag(parts[0]),
+		name:        parts[1],
+		revision:    parts[2],
+	}
+}
+
+// ParseResourceTag parses a resource tag string.
+func ParseResourceTag(resourceTag string) (ResourceTag, error) {
+	return ParseAs[ResourceTag](resourceTag)
+}
+
+func resourceTagSuffixToId(s string) string {
+	return strings.Replace(s, ".", "/", -1)
+}
+
+// TryNewResourceTag returns the tag for the given id, or an error if id is
+// not a valid resource id.
+func TryNewResourceTag(id string) (ResourceTag, error) {
+	if !IsValidResource(id) {
+		return ResourceTag{}, fmt.Errorf("%q is not a valid resource id", id)
+	}
+	return NewResourceTag(id), nil
+}
+
+// MustNewResourceTag returns the tag for the given id.
+// It will panic if id is not a valid resource id.
+func MustNewResourceTag(id string) ResourceTag {
+	return mustTag(TryNewResourceTag(id))
+}
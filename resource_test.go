@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type resourceSuite struct{}
+
+var _ = gc.Suite(&resourceSuite{})
+
+var resourceIdTests = []struct {
+	id          string
+	valid       bool
+	application string
+	name        string
+	revision    int
+	hasRevision bool
+	tag         string
+}{
+	{id: "mysql/image", valid: true, application: "mysql", name: "image", tag: "resource-mysql.image"},
+	{id: "mysql-router/mysql-image", valid: true, application: "mysql-router", name: "mysql-image", tag: "resource-mysql-router.mysql-image"},
+	{id: "foo-bar/config_file/0", valid: true, application: "foo-bar", name: "config_file", revision: 0, hasRevision: true, tag: "resource-foo-bar.config_file.0"},
+	{id: "foo/image-2/12", valid: true, application: "foo", name: "image-2", revision: 12, hasRevision: true, tag: "resource-foo.image-2.12"},
+	{id: "foo", valid: false},
+	{id: "foo/", valid: false},
+	{id: "foo/Image", valid: false},
+	{id: "foo/image/", valid: false},
+	{id: "foo/image/01", valid: false},
+	{id: "foo-1/image", valid: false},
+	{id: "foo/image.tar", valid: false},
+	{id: "mysql/data/99999999999999999999", valid: false},
+}
+
+func (s *resourceSuite) TestResourceIds(c *gc.C) {
+	for i, test := range resourceIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidResource(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expect := fmt.Sprintf("%q is not a valid resource id", test.id)
+			c.Check(func() { names.NewResourceTag(test.id) }, gc.PanicMatches, expect)
+			continue
+		}
+		tag := names.NewResourceTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Application(), gc.Equals, names.NewApplicationTag(test.application))
+		c.Check(tag.Name(), gc.Equals, test.name)
+		rev, ok := tag.Revision()
+		c.Check(ok, gc.Equals, test.hasRevision)
+		c.Check(rev, gc.Equals, test.revision)
+
+		parsed, err := names.ParseResourceTag(test.tag)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *resourceSuite) TestIsValidResourceName(c *gc.C) {
+	c.Assert(names.IsValidResourceName("mysql-image"), jc.IsTrue)
+	c.Assert(names.IsValidResourceName("config_file"), jc.IsTrue)
+	c.Assert(names.IsValidResourceName("mysql/image"), jc.IsFalse)
+	c.Assert(names.IsValidResourceName("-image"), jc.IsFalse)
+}
+
+func (s *resourceSuite) TestWithRevision(c *gc.C) {
+	tag := names.NewResourceTag("mysql/image")
+	c.Assert(tag.WithRevision(4), gc.Equals, names.NewResourceTag("mysql/image/4"))
+	c.Assert(tag.WithRevision(4).WithoutRevision(), gc.Equals, tag)
+	c.Assert(func() { tag.WithRevision(-1) }, gc.PanicMatches, "invalid resource revision -1")
+}
+
+var parseResourceTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{
+	{tag: "", err: names.InvalidTagError("", "")},
+	{tag: "resource-mysql.image", expected: names.NewResourceTag("mysql/image")},
+	{tag: "resource-mysql-image", err: names.InvalidTagError("resource-mysql-image", names.ResourceTagKind)},
+	{tag: "resource-mysql.image.1.2", err: names.InvalidTagError("resource-mysql.image.1.2", names.ResourceTagKind)},
+	{tag: "resource-mysql.data.99999999999999999999", err: names.InvalidTagError("resource-mysql.data.99999999999999999999", names.ResourceTagKind)},
+	{tag: "application-mysql", err: names.InvalidTagError("application-mysql", names.ResourceTagKind)},
+}
+
+func (s *resourceSuite) TestParseResourceTag(c *gc.C) {
+	for i, t := range parseResourceTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseResourceTag(t.tag)
+		if t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
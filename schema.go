@@ -0,0 +1,307 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Schema is a JSON Schema definition of a string type, such as a tag
+// or an id. Pattern uses the ECMAScript regular expression flavour
+// required by JSON Schema, and is anchored at both ends.
+type Schema struct {
+	Type        string   `json:"type"`
+	Pattern     string   `json:"pattern"`
+	Description string   `json:"description,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+	Deprecated  bool     `json:"deprecated,omitempty"`
+}
+
+// nameSchemas describes ids that are not tag ids in their own right,
+// but are commonly validated on their own.
+var nameSchemas = []struct {
+	name        string
+	description string
+	pattern     string
+	examples    []string
+}{
+	{"ApplicationName", "Application name", ApplicationSnippet, []string{"wordpress"}},
+	{"CharmName", "Charm name", CharmNameSnippet, []string{"mysql"}},
+	{"CloudCredentialName", "Cloud credential name", cloudCredentialNameSnippet, []string{"default"}},
+	{"CloudRegionName", "Cloud region name", cloudRegionNameSnippet, []string{"us-east-1"}},
+	{"ControllerName", "Controller name", controllerNameSnippet, []string{"prod"}},
+	{"LinkLayerDeviceName", "Link-layer device name", LinkLayerDeviceNameSnippet, []string{"eth0"}},
+	{"ModelName", "Model name", modelNameSnippet, []string{"default"}},
+	{"RelationName", "Relation endpoint name", RelationSnippet, []string{"db"}},
+	{"ResourceName", "Resource name", ResourceNameSnippet, []string{"data"}},
+	{"SpaceName", "Space name", SpaceSnippet, []string{"dmz"}},
+	{"StorageName", "Storage name", StorageNameSnippet, []string{"data"}},
+	{"UserName", "User name, without domain", validUserNameSnippet, []string{"bob"}},
+}
+
+// Schemas returns JSON Schema definitions for the tags and ids of every
+// kind described by Kinds, and for the names they are built from. Tag
+// definitions are named after the Go type (e.g. "UnitTag"), id
+// definitions add "Id" (e.g. "UnitTagId"), and names are named as in
+// "ApplicationName".
+func Schemas() map[string]Schema {
+	schemas := make(map[string]Schema)
+	for _, info := range Kinds() {
+		typeName := info.Type.Name()
+		schemas[typeName] = Schema{
+			Type:        "string",
+			Pattern:     mustECMAScriptPattern("^" + regexp.QuoteMeta(info.Kind) + "-" + info.TagPattern + "$"),
+			Description: info.Label + " tag",
+			Examples:    info.ExampleTags,
+			Deprecated:  info.Deprecated,
+		}
+		schemas[typeName+"Id"] = Schema{
+			Type:        "string",
+			Pattern:     mustECMAScriptPattern("^" + info.IDPattern + "$"),
+			Description: info.Label + " id",
+			Examples:    info.ExampleIDs,
+			Deprecated:  info.Deprecated,
+		}
+	}
+	for _, n := range nameSchemas {
+		schemas[n.name] = Schema{
+			Type:        "string",
+			Pattern:     mustECMAScriptPattern("^(?:" + n.pattern + ")$"),
+			Description: n.description,
+			Examples:    append([]string(nil), n.examples...),
+		}
+	}
+	return schemas
+}
+
+func mustECMAScriptPattern(pattern string) string {
+	p, err := ECMAScriptPattern(pattern)
+	if err != nil {
+		panic(err.Error())
+	}
+	return p
+}
+
+// ECMAScriptPattern translates a Go (RE2) regular expression into an
+// equivalent ECMAScript one, as used by JSON Schema, OpenAPI and
+// browsers. Named groups are written as (?<name>...) and case folding
+// is expanded into character classes. An error is returned for
+// constructs ECMAScript cannot express, such as multi-line anchors.
+func ECMAScriptPattern(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := writeECMAScript(&b, re); err != nil {
+		return "", fmt.Errorf("cannot translate %q to ECMAScript: %w", pattern, err)
+	}
+	return b.String(), nil
+}
+
+func writeECMAScript(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		b.WriteString(`[^\s\S]`)
+	case syntax.OpEmptyMatch:
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && hasFold(r) {
+				if err := writeECMAScriptClass(b, foldRanges(r), false); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := writeECMAScriptRune(b, r, false); err != nil {
+				return err
+			}
+		}
+	case syntax.OpCharClass:
+		return writeECMAScriptClass(b, re.Rune, true)
+	case syntax.OpAnyCharNotNL:
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginText:
+		b.WriteString("^")
+	case syntax.OpEndText:
+		if re.Flags&syntax.WasDollar == 0 {
+			return fmt.Errorf(`\z is not supported`)
+		}
+		b.WriteString("$")
+	case syntax.OpBeginLine, syntax.OpEndLine:
+		return fmt.Errorf("multi-line anchors are not supported")
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		b.WriteString("(")
+		if re.Name != "" {
+			b.WriteString("?<" + re.Name + ">")
+		}
+		if err := writeECMAScript(b, re.Sub[0]); err != nil {
+			return err
+		}
+		b.WriteString(")")
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		if err := writeECMAScriptAtom(b, re.Sub[0]); err != nil {
+			return err
+		}
+		switch re.Op {
+		case syntax.OpStar:
+			b.WriteString("*")
+		case syntax.OpPlus:
+			b.WriteString("+")
+		case syntax.OpQuest:
+			b.WriteString("?")
+		default:
+			b.WriteString("{" + strconv.Itoa(re.Min))
+			if re.Max != re.Min {
+				b.WriteString(",")
+				if re.Max >= 0 {
+					b.WriteString(strconv.Itoa(re.Max))
+				}
+			}
+			b.WriteString("}")
+		}
+		if re.Flags&syntax.NonGreedy != 0 {
+			b.WriteString("?")
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpAlternate {
+				if err := writeECMAScriptGroup(b, sub); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := writeECMAScript(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range re.Sub {
+			if i > 0 {
+				b.WriteString("|")
+			}
+			if err := writeECMAScript(b, sub); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported operator %v", re.Op)
+	}
+	return nil
+}
+
+// writeECMAScriptAtom writes re so that a following quantifier applies
+// to all of it.
+func writeECMAScriptAtom(b *strings.Builder, re *syntax.Regexp) error {
+	switch {
+	case re.Op == syntax.OpCharClass, re.Op == syntax.OpAnyChar, re.Op == syntax.OpAnyCharNotNL,
+		re.Op == syntax.OpCapture, re.Op == syntax.OpLiteral && len(re.Rune) == 1:
+		return writeECMAScript(b, re)
+	}
+	return writeECMAScriptGroup(b, re)
+}
+
+func writeECMAScriptGroup(b *strings.Builder, re *syntax.Regexp) error {
+	b.WriteString("(?:")
+	if err := writeECMAScript(b, re); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+// writeECMAScriptClass writes a class given as pairs of inclusive rune
+// ranges. Classes reaching the top of the Unicode range, as produced by
+// negation, are written negated so that no astral code points appear.
+func writeECMAScriptClass(b *strings.Builder, ranges []rune, allowNegate bool) error {
+	negated := false
+	if allowNegate && len(ranges) > 0 && ranges[len(ranges)-1] == unicode.MaxRune {
+		ranges = complementRanges(ranges)
+		negated = true
+	}
+	b.WriteString("[")
+	if negated {
+		b.WriteString("^")
+	}
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if err := writeECMAScriptRune(b, lo, true); err != nil {
+			return err
+		}
+		if hi != lo {
+			if hi > lo+1 {
+				b.WriteString("-")
+			}
+			if err := writeECMAScriptRune(b, hi, true); err != nil {
+				return err
+			}
+		}
+	}
+	b.WriteString("]")
+	return nil
+}
+
+func complementRanges(ranges []rune) []rune {
+	var result []rune
+	next := rune(0)
+	for i := 0; i < len(ranges); i += 2 {
+		if ranges[i] > next {
+			result = append(result, next, ranges[i]-1)
+		}
+		next = ranges[i+1] + 1
+	}
+	if next <= unicode.MaxRune {
+		result = append(result, next, unicode.MaxRune)
+	}
+	return result
+}
+
+func writeECMAScriptRune(b *strings.Builder, r rune, inClass bool) error {
+	switch {
+	case r > 0xffff:
+		return fmt.Errorf("code point %U is not supported", r)
+	case r == '\n':
+		b.WriteString(`\n`)
+	case r == '\t':
+		b.WriteString(`\t`)
+	case r < 0x20 || r > 0x7e:
+		fmt.Fprintf(b, `\u%04x`, r)
+	case inClass && strings.ContainsRune(`\]^-[`, r):
+		b.WriteString(`\` + string(r))
+	case !inClass && strings.ContainsRune(`\^$.|?*+()[]{}/`, r):
+		b.WriteString(`\` + string(r))
+	default:
+		b.WriteRune(r)
+	}
+	return nil
+}
+
+func hasFold(r rune) bool {
+	return unicode.SimpleFold(r) != r
+}
+
+// foldRanges returns the case folding orbit of r as sorted class ranges.
+func foldRanges(r rune) []rune {
+	runes := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		runes = append(runes, f)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	var ranges []rune
+	for _, f := range runes {
+		ranges = append(ranges, f, f)
+	}
+	return ranges
+}
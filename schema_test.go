@@ -0,0 +1,115 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"encoding/json"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type schemaSuite struct{}
+
+var _ = gc.Suite(&schemaSuite{})
+
+func (s *schemaSuite) TestSchemasCoverKinds(c *gc.C) {
+	schemas := names.Schemas()
+	for _, info := range names.Kinds() {
+		_, ok := schemas[info.Type.Name()]
+		c.Check(ok, gc.Equals, true, gc.Commentf("%s", info.Type.Name()))
+		_, ok = schemas[info.Type.Name()+"Id"]
+		c.Check(ok, gc.Equals, true, gc.Commentf("%sId", info.Type.Name()))
+	}
+	for _, name := range []string{"ApplicationName", "ModelName", "ControllerName", "StorageName", "RelationName"} {
+		_, ok := schemas[name]
+		c.Check(ok, gc.Equals, true, gc.Commentf("%s", name))
+	}
+	c.Check(schemas["EnvironTag"].Deprecated, gc.Equals, true)
+	c.Check(schemas["UnitTag"].Deprecated, gc.Equals, false)
+}
+
+func (s *schemaSuite) TestExamplesMatchPatterns(c *gc.C) {
+	// The ECMAScript patterns used here are also valid RE2, so the
+	// examples can be checked against them directly.
+	for name, schema := range names.Schemas() {
+		c.Logf("schema %s: %s", name, schema.Pattern)
+		c.Check(schema.Type, gc.Equals, "string")
+		c.Check(schema.Description, gc.Not(gc.Equals), "")
+		c.Check(schema.Examples, gc.Not(gc.HasLen), 0)
+		pattern := regexp.MustCompile(schema.Pattern)
+		for _, example := range schema.Examples {
+			c.Check(pattern.MatchString(example), gc.Equals, true, gc.Commentf("%q", example))
+		}
+	}
+}
+
+var schemaRejectTests = []struct {
+	schema string
+	value  string
+}{
+	{"UnitTag", "unit-wordpress"},
+	{"UnitTag", "unit-wordpress/0"},
+	{"UnitTagId", "wordpress-0"},
+	{"MachineTagId", "0/lxd"},
+	{"MachineTag", "machine-0/lxd/1"},
+	{"ModelTagId", "not-a-uuid"},
+	{"ApplicationName", "wordpress-1"},
+	{"CloudCredentialTag", "cloudcred-aws_bob"},
+	{"RelationTag", "relation-wordpress:db mysql:server"},
+}
+
+func (s *schemaSuite) TestPatternsReject(c *gc.C) {
+	schemas := names.Schemas()
+	for i, t := range schemaRejectTests {
+		c.Logf("test %d: %s %q", i, t.schema, t.value)
+		pattern := regexp.MustCompile(schemas[t.schema].Pattern)
+		c.Check(pattern.MatchString(t.value), gc.Equals, false)
+	}
+}
+
+func (s *schemaSuite) TestUnitSchema(c *gc.C) {
+	schema := names.Schemas()["UnitTagId"]
+	c.Assert(schema.Pattern, gc.Equals, `^[a-z][0-9a-z]*(?:-[0-9a-z]*[a-z][0-9a-z]*)*\/(?:0|[1-9][0-9]*)$`)
+
+	data, err := json.Marshal(schema)
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(data), gc.Equals,
+		`{"type":"string","pattern":"^[a-z][0-9a-z]*(?:-[0-9a-z]*[a-z][0-9a-z]*)*\\/(?:0|[1-9][0-9]*)$","description":"Unit id","examples":["wordpress/0"]}`)
+}
+
+var ecmaScriptPatternTests = []struct {
+	pattern string
+	expect  string
+	err     string
+}{
+	{pattern: names.NumberSnippet, expect: `0|[1-9][0-9]*`},
+	{pattern: "^" + names.UnitSnippet + "$", expect: `^([a-z][0-9a-z]*(?:-[0-9a-z]*[a-z][0-9a-z]*)*)\/(0|[1-9][0-9]*)$`},
+	{pattern: `(?P<name>[a-z]+)@(?P<domain>[a-z]+)`, expect: `(?<name>[a-z]+)@(?<domain>[a-z]+)`},
+	{pattern: `(?i)ab`, expect: `[Aa][Bb]`},
+	{pattern: `[^a-c]`, expect: `[^a-c]`},
+	{pattern: `a.b`, expect: `a[^\n]b`},
+	{pattern: `(?:ab)+?c{2,}d{3}e{1,2}`, expect: `(?:ab)+?c{2,}d{3}e{1,2}`},
+	{pattern: `[\]\-^]`, expect: `[\-\]\^]`},
+	{pattern: `a/b\.c`, expect: `a\/b\.c`},
+	{pattern: `\z`, err: `cannot translate "\\\\z" to ECMAScript: \\z is not supported`},
+	{pattern: `(?m)^a`, err: `cannot translate .* multi-line anchors are not supported`},
+	{pattern: `\x{1F600}`, err: `cannot translate .* code point U\+1F600 is not supported`},
+	{pattern: `(`, err: "error parsing regexp: .*"},
+}
+
+func (s *schemaSuite) TestECMAScriptPattern(c *gc.C) {
+	for i, t := range ecmaScriptPatternTests {
+		c.Logf("test %d: %q", i, t.pattern)
+		got, err := names.ECMAScriptPattern(t.pattern)
+		if t.err != "" {
+			c.Check(err, gc.ErrorMatches, t.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(got, gc.Equals, t.expect)
+	}
+}
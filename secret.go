@@ -0,0 +1,166 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// SecretTagKind is used as the prefix for the string
+	// representation of secret tags.
+	SecretTagKind = "secret"
+
+	// SecretSnippet is a non-compiled regexp that can be composed with
+	// other snippets for validating secret ids. Secret ids are xids,
+	// 20 characters of lowercase base32hex.
+	SecretSnippet = "[0-9a-v]{20}"
+
+	// secretURIScheme is the scheme of a secret URI.
+	secretURIScheme = "secret"
+)
+
+var (
+	validSecret    = regexp.MustCompile("^" + SecretSnippet + "$")
+	validSecretURI = regexp.MustCompile(
+		"^" + secretURIScheme + ":" +
+			"(?://(" + uuidSnippet + ")/)?" + // source model
+			"(" + SecretSnippet + ")" +
+			"(?:/(" + NumberSnippet + "))?" + // revision
+			"$",
+	)
+)
+
+// IsValidSecret returns whether id is a valid secret id.
+func IsValidSecret(id string) bool {
+	return validSecret.MatchString(id)
+}
+
+// SecretTag represents a secret.
+type SecretTag struct {
+	id string
+}
+
+func (t SecretTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t SecretTag) Kind() string   { return SecretTagKind }
+func (t SecretTag) Id() string     { return t.id }
+
+// NewSecretTag returns the tag for the secret with the given id.
+// It will panic if the given id is not valid.
+func NewSecretTag(id string) SecretTag {
+	if !IsValidSecret(id) {
+		panic(fmt.Sprintf("%q is not a valid secret id", id))
+	}
+	return SecretTag{id: id}
+}
+
+// ParseSecretTag parses a secret tag string.
+func ParseSecretTag(secretTag string) (SecretTag, error) {
+	return ParseAs[SecretTag](secretTag)
+}
+
+// SecretURI references a secret, optionally qualified by the UUID of
+// the model the secret was created in.
+//
+// The string form is "secret:<id>" for a secret in the current model,
+// or "secret://<model-uuid>/<id>" for a secret from another model.
+// SecretURIs should be made with NewSecretURI or ParseSecretURI; the
+// methods that make tags or other URIs panic on invalid fields.
+type SecretURI struct {
+	ID string
+
+	// SourceUUID is the UUID of the model the secret was created in.
+	// It is empty if the secret belongs to the current model.
+	SourceUUID string
+}
+
+// NewSecretURI returns a URI for the secret identified by tag.
+func NewSecretURI(tag SecretTag) SecretURI {
+	return SecretURI{ID: tag.Id()}
+}
+
+// ParseSecretURI parses a secret URI string.
+func ParseSecretURI(s string) (SecretURI, error) {
+	parts := validSecretURI.FindStringSubmatch(s)
+	if parts == nil || parts[3] != "" {
+		return SecretURI{}, fmt.Errorf("%q is not a valid secret URI", s)
+	}
+	return SecretURI{ID: parts[2], SourceUUID: parts[1]}, nil
+}
+
+// WithSource returns a copy of the URI qualified by the given source
+// model UUID. It will panic if modelUUID is not a valid model UUID.
+func (u SecretURI) WithSource(modelUUID string) SecretURI {
+	if !IsValidModel(modelUUID) {
+		panic(fmt.Sprintf("%q is not a valid model UUID", modelUUID))
+	}
+	u.SourceUUID = modelUUID
+	return u
+}
+
+// IsLocal reports whether the URI is not qualified by a source model.
+func (u SecretURI) IsLocal() bool {
+	return u.SourceUUID == ""
+}
+
+// Tag returns the tag of the referenced secret.
+// It will panic if the URI's ID is not a valid secret id.
+func (u SecretURI) Tag() SecretTag {
+	return NewSecretTag(u.ID)
+}
+
+// String returns the canonical string form of the URI.
+func (u SecretURI) String() string {
+	if u.SourceUUID == "" {
+		return secretURIScheme + ":" + u.ID
+	}
+	return secretURIScheme + "://" + u.SourceUUID + "/" + u.ID
+}
+
+// SecretRevision references a specific revision of a secret.
+//
+// The string form is the secret URI followed by "/<revision>",
+// for example "secret:9m4e2mr0ui3e8a215n4g/3".
+type SecretRevision struct {
+	URI      SecretURI
+	Revision int
+}
+
+// ParseSecretRevision parses a secret revision string.
+func ParseSecretRevision(s string) (SecretRevision, error) {
+	parts := validSecretURI.FindStringSubmatch(s)
+	if parts == nil || parts[3] == "" {
+		return SecretRevision{}, fmt.Errorf("%q is not a valid secret revision", s)
+	}
+	rev, err := strconv.Atoi(parts[3])
+	if err != nil || rev < 1 {
+		return SecretRevision{}, fmt.Errorf("%q is not a valid secret revision", s)
+	}
+	return SecretRevision{
+		URI:      SecretURI{ID: parts[2], SourceUUID: parts[1]},
+		Revision: rev,
+	}, nil
+}
+
+// String returns the canonical string form of the secret revision.
+func (r SecretRevision) String() string {
+	return r.URI.String() + "/" + strconv.Itoa(r.Revision)
+}
+
+// TryNewSecretTag returns the tag for the given id, or an error if id is
+// not a valid secret id.
+func TryNewSecretTag(id string) (SecretTag, error) {
+	if !IsValidSecret(id) {
+		return SecretTag{}, fmt.Errorf("%q is not a valid secret id", id)
+	}
+	return NewSecretTag(id), nil
+}
+
+// MustNewSecretTag returns the tag for the given id.
+// It will panic if id is not a valid secret id.
+func MustNewSecretTag(id string) SecretTag {
+	return mustTag(TryNewSecretTag(id))
+}
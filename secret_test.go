@@ -0,0 +1,158 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type secretSuite struct{}
+
+var _ = gc.Suite(&secretSuite{})
+
+var secretIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "9m4e2mr0ui3e8a215n4g", valid: true},
+	{id: "00000000000000000000", valid: true},
+	{id: "vvvvvvvvvvvvvvvvvvvv", valid: true},
+	{id: "", valid: false},
+	{id: "9m4e2mr0ui3e8a215n4", valid: false},
+	{id: "9m4e2mr0ui3e8a215n4gg", valid: false},
+	{id: "9m4e2mr0ui3e8a215n4w", valid: false},
+	{id: "9M4E2MR0UI3E8A215N4G", valid: false},
+}
+
+func (s *secretSuite) TestIsValidSecret(c *gc.C) {
+	for i, test := range secretIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidSecret(test.id), gc.Equals, test.valid)
+		if test.valid {
+			c.Check(names.NewSecretTag(test.id).String(), gc.Equals, "secret-"+test.id)
+		} else {
+			expect := fmt.Sprintf("%q is not a valid secret id", test.id)
+			c.Check(func() { names.NewSecretTag(test.id) }, gc.PanicMatches, expect)
+		}
+	}
+}
+
+var parseSecretTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{
+	{tag: "", err: names.InvalidTagError("", "")},
+	{tag: "secret-9m4e2mr0ui3e8a215n4g", expected: names.NewSecretTag("9m4e2mr0ui3e8a215n4g")},
+	{tag: "secret-foo", err: names.InvalidTagError("secret-foo", names.SecretTagKind)},
+	{tag: "application-foo", err: names.InvalidTagError("application-foo", names.SecretTagKind)},
+}
+
+func (s *secretSuite) TestParseSecretTag(c *gc.C) {
+	for i, t := range parseSecretTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseSecretTag(t.tag)
+		if t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+var parseSecretURITests = []struct {
+	uri    string
+	id     string
+	source string
+	err    string
+}{
+	{uri: "secret:9m4e2mr0ui3e8a215n4g", id: "9m4e2mr0ui3e8a215n4g"},
+	{
+		uri:    "secret://f47ac10b-58cc-4372-a567-0e02b2c3d479/9m4e2mr0ui3e8a215n4g",
+		id:     "9m4e2mr0ui3e8a215n4g",
+		source: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	},
+	{uri: "9m4e2mr0ui3e8a215n4g", err: `"9m4e2mr0ui3e8a215n4g" is not a valid secret URI`},
+	{uri: "secret-9m4e2mr0ui3e8a215n4g", err: `"secret-9m4e2mr0ui3e8a215n4g" is not a valid secret URI`},
+	{uri: "secret:9m4e2mr0ui3e8a215n4g/1", err: `"secret:9m4e2mr0ui3e8a215n4g/1" is not a valid secret URI`},
+	{uri: "secret://foo/9m4e2mr0ui3e8a215n4g", err: `"secret://foo/9m4e2mr0ui3e8a215n4g" is not a valid secret URI`},
+}
+
+func (s *secretSuite) TestParseSecretURI(c *gc.C) {
+	for i, test := range parseSecretURITests {
+		c.Logf("test %d: %q", i, test.uri)
+		uri, err := names.ParseSecretURI(test.uri)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(uri.ID, gc.Equals, test.id)
+		c.Check(uri.SourceUUID, gc.Equals, test.source)
+		c.Check(uri.IsLocal(), gc.Equals, test.source == "")
+		c.Check(uri.Tag(), gc.Equals, names.NewSecretTag(test.id))
+		c.Check(uri.String(), gc.Equals, test.uri)
+	}
+}
+
+func (s *secretSuite) TestSecretURIWithSource(c *gc.C) {
+	uri := names.NewSecretURI(names.NewSecretTag("9m4e2mr0ui3e8a215n4g"))
+	c.Assert(uri.String(), gc.Equals, "secret:9m4e2mr0ui3e8a215n4g")
+	uri = uri.WithSource("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(uri.String(), gc.Equals, "secret://f47ac10b-58cc-4372-a567-0e02b2c3d479/9m4e2mr0ui3e8a215n4g")
+	c.Assert(func() { uri.WithSource("x") }, gc.PanicMatches, `"x" is not a valid model UUID`)
+}
+
+func (s *secretSuite) TestSecretURITagInvalid(c *gc.C) {
+	c.Assert(func() { names.SecretURI{ID: "x"}.Tag() }, gc.PanicMatches, `"x" is not a valid secret id`)
+}
+
+var parseSecretRevisionTests = []struct {
+	ref      string
+	uri      string
+	revision int
+	err      string
+}{
+	{ref: "secret:9m4e2mr0ui3e8a215n4g/1", uri: "secret:9m4e2mr0ui3e8a215n4g", revision: 1},
+	{
+		ref:      "secret://f47ac10b-58cc-4372-a567-0e02b2c3d479/9m4e2mr0ui3e8a215n4g/42",
+		uri:      "secret://f47ac10b-58cc-4372-a567-0e02b2c3d479/9m4e2mr0ui3e8a215n4g",
+		revision: 42,
+	},
+	{ref: "secret:9m4e2mr0ui3e8a215n4g", err: `"secret:9m4e2mr0ui3e8a215n4g" is not a valid secret revision`},
+	{ref: "secret:9m4e2mr0ui3e8a215n4g/0", err: `"secret:9m4e2mr0ui3e8a215n4g/0" is not a valid secret revision`},
+	{ref: "secret:9m4e2mr0ui3e8a215n4g/01", err: `"secret:9m4e2mr0ui3e8a215n4g/01" is not a valid secret revision`},
+	{ref: "secret:9m4e2mr0ui3e8a215n4g/x", err: `"secret:9m4e2mr0ui3e8a215n4g/x" is not a valid secret revision`},
+}
+
+func (s *secretSuite) TestParseSecretRevision(c *gc.C) {
+	for i, test := range parseSecretRevisionTests {
+		c.Logf("test %d: %q", i, test.ref)
+		ref, err := names.ParseSecretRevision(test.ref)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(ref.URI.String(), gc.Equals, test.uri)
+		c.Check(ref.Revision, gc.Equals, test.revision)
+		c.Check(ref.String(), gc.Equals, test.ref)
+	}
+}
+
+func (s *secretSuite) TestSecretTagInSet(c *gc.C) {
+	set, err := names.NewSetFromStrings("secret-9m4e2mr0ui3e8a215n4g", "unit-mysql-0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(set.Contains(names.NewSecretTag("9m4e2mr0ui3e8a215n4g")), jc.IsTrue)
+	c.Assert(set.SortedValues(), jc.DeepEquals, []names.Tag{
+		names.NewSecretTag("9m4e2mr0ui3e8a215n4g"),
+		names.NewUnitTag("mysql/0"),
+	})
+}
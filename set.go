@@ -4,7 +4,7 @@
 package names
 
 import (
-	"sort"
+	"iter"
 
 	"github.com/juju/errors"
 )
@@ -77,31 +77,37 @@ func (t Set) Values() []Tag {
 	return result
 }
 
-// stringValues returns a list of strings that represent a Tag.
-// Used internally by the SortedValues method.
-func (t Set) stringValues() []string {
-	result := make([]string, t.Size())
-	i := 0
-	for key := range t {
-		result[i] = key.String()
-		i++
-	}
-	return result
-}
-
 // SortedValues returns an ordered slice containing all the values in the set.
 func (t Set) SortedValues() []Tag {
-	values := t.stringValues()
-	sort.Strings(values)
+	values := t.Values()
+	sortTags(values)
+	return values
+}
 
-	result := make([]Tag, len(values))
-	for i, value := range values {
-		// We already know only good strings can live in the Tags set
-		// so we can safely ignore the error here.
-		tag, _ := ParseTag(value)
-		result[i] = tag
+// All returns an iterator over the values in the set, in no particular
+// order. Unlike Values, it does not copy the set.
+func (t Set) All() iter.Seq[Tag] {
+	return func(yield func(Tag) bool) {
+		for value := range t {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the values in the set, in the order
+// used by SortedValues. The values are sorted when iteration starts.
+func (t Set) Sorted() iter.Seq[Tag] {
+	return func(yield func(Tag) bool) {
+		values := t.Values()
+		sortTags(values)
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
 	}
-	return result
 }
 
 // Union returns a new Set representing a union of the elments in the
@@ -146,3 +152,150 @@ func (t Set) Difference(other Set) Set {
 	}
 	return result
 }
+
+// SymmetricDifference returns a new Set representing the values that are
+// in either the target or the parameter, but not in both.
+func (t Set) SymmetricDifference(other Set) Set {
+	result := make(Set)
+	for value := range t {
+		if !other.Contains(value) {
+			result[value] = true
+		}
+	}
+	for value := range other {
+		if !t.Contains(value) {
+			result[value] = true
+		}
+	}
+	return result
+}
+
+// IsSubset returns true if every value in the target is also in the
+// parameter.
+func (t Set) IsSubset(other Set) bool {
+	if len(t) > len(other) {
+		return false
+	}
+	for value := range t {
+		if !other.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every value in the parameter is also in the
+// target.
+func (t Set) IsSuperset(other Set) bool {
+	return other.IsSubset(t)
+}
+
+// Equal returns true if the target and the parameter hold the same
+// values. Nil and empty sets are equal.
+func (t Set) Equal(other Set) bool {
+	return len(t) == len(other) && t.IsSubset(other)
+}
+
+// Clone returns a new Set holding the values in the target.
+func (t Set) Clone() Set {
+	result := make(Set, len(t))
+	for value := range t {
+		result[value] = true
+	}
+	return result
+}
+
+// UnitsOf returns a new Set containing the unit tags in the target
+// which belong to the given application.
+func (t Set) UnitsOf(app ApplicationTag) Set {
+	result := make(Set)
+	for value := range t {
+		if ut, ok := value.(UnitTag); ok && ut.Application() == app {
+			result[value] = true
+		}
+	}
+	return result
+}
+
+// GroupByApplication returns the unit tags in the target keyed by the
+// application they belong to. Tags other than units are ignored.
+func (t Set) GroupByApplication() map[ApplicationTag]Set {
+	result := make(map[ApplicationTag]Set)
+	for value := range t {
+		ut, ok := value.(UnitTag)
+		if !ok {
+			continue
+		}
+		app := ut.Application()
+		if result[app] == nil {
+			result[app] = make(Set)
+		}
+		result[app][value] = true
+	}
+	return result
+}
+
+// SplitSubordinates partitions the unit tags in the target into principal
+// and subordinate units, using isSubordinate to report whether an
+// application is a subordinate application. Tags other than units are
+// ignored.
+func (t Set) SplitSubordinates(isSubordinate func(ApplicationTag) bool) (principals, subordinates Set) {
+	principals, subordinates = make(Set), make(Set)
+	for value := range t {
+		ut, ok := value.(UnitTag)
+		if !ok {
+			continue
+		}
+		if isSubordinate(ut.Application()) {
+			subordinates[value] = true
+		} else {
+			principals[value] = true
+		}
+	}
+	return principals, subordinates
+}
+
+// Filter returns a new Set containing the values in the target for which
+// keep returns true.
+func (t Set) Filter(keep func(Tag) bool) Set {
+	result := make(Set)
+	for value := range t {
+		if keep(value) {
+			result[value] = true
+		}
+	}
+	return result
+}
+
+// Partition splits the values in the target into those for which match
+// returns true and the rest.
+func (t Set) Partition(match func(Tag) bool) (matched, rest Set) {
+	matched, rest = make(Set), make(Set)
+	for value := range t {
+		if match(value) {
+			matched[value] = true
+		} else {
+			rest[value] = true
+		}
+	}
+	return matched, rest
+}
+
+// GroupByKind returns the values in the target keyed by their kind.
+func (t Set) GroupByKind() map[string]Set {
+	result := make(map[string]Set)
+	for value := range t {
+		kind := value.Kind()
+		if result[kind] == nil {
+			result[kind] = make(Set)
+		}
+		result[kind][value] = true
+	}
+	return result
+}
+
+// Lazy returns a lazily evaluated sequence over the values in the target,
+// for chaining operations without building intermediate sets.
+func (t Set) Lazy() TagSeq {
+	return TagSeq(t.All())
+}
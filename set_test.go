@@ -187,6 +187,36 @@ func (s tagSetSuite) TestDifference(c *gc.C) {
 	c.Assert(diff2, gc.DeepEquals, names.NewSet(s.baz, s.bang))
 }
 
+func (s tagSetSuite) TestUnitsOf(c *gc.C) {
+	wordpress1 := names.NewUnitTag("wordpress/1")
+	t := names.NewSet(s.foo, s.bar, s.baz, s.bang, wordpress1, names.NewApplicationTag("wordpress"))
+
+	c.Assert(t.UnitsOf(names.NewApplicationTag("wordpress")), gc.DeepEquals, names.NewSet(s.foo, wordpress1))
+	c.Assert(t.UnitsOf(names.NewApplicationTag("rabbitmq")), gc.DeepEquals, names.NewSet())
+}
+
+func (s tagSetSuite) TestGroupByApplication(c *gc.C) {
+	wordpress1 := names.NewUnitTag("wordpress/1")
+	t := names.NewSet(s.foo, s.bar, s.baz, s.bang, wordpress1)
+
+	c.Assert(t.GroupByApplication(), gc.DeepEquals, map[names.ApplicationTag]names.Set{
+		names.NewApplicationTag("wordpress"):       names.NewSet(s.foo, wordpress1),
+		names.NewApplicationTag("rabbitmq-server"): names.NewSet(s.bar),
+		names.NewApplicationTag("mongodb"):         names.NewSet(s.baz),
+	})
+}
+
+func (s tagSetSuite) TestSplitSubordinates(c *gc.C) {
+	telegraf := names.NewUnitTag("telegraf/0")
+	t := names.NewSet(s.foo, s.bar, s.bang, telegraf)
+
+	principals, subordinates := t.SplitSubordinates(func(app names.ApplicationTag) bool {
+		return app.Name == "telegraf"
+	})
+	c.Assert(principals, gc.DeepEquals, names.NewSet(s.foo, s.bar))
+	c.Assert(subordinates, gc.DeepEquals, names.NewSet(telegraf))
+}
+
 func (s tagSetSuite) TestUninitializedPanics(c *gc.C) {
 	f := func() {
 		var t names.Set
@@ -194,3 +224,85 @@ func (s tagSetSuite) TestUninitializedPanics(c *gc.C) {
 	}
 	c.Assert(f, gc.PanicMatches, "uninitalised set")
 }
+
+func (s tagSetSuite) TestAll(c *gc.C) {
+	t := names.NewSet(s.foo, s.bar, s.bang)
+	seen := names.NewSet()
+	for tag := range t.All() {
+		seen.Add(tag)
+	}
+	c.Assert(seen, gc.DeepEquals, t)
+
+	count := 0
+	for range t.All() {
+		count++
+		break
+	}
+	c.Assert(count, gc.Equals, 1)
+}
+
+func (s tagSetSuite) TestSorted(c *gc.C) {
+	t := names.NewSet(s.foo, s.bang, s.baz, s.bar)
+	var values []names.Tag
+	for tag := range t.Sorted() {
+		values = append(values, tag)
+	}
+	c.Assert(values, gc.DeepEquals, []names.Tag{s.bang, s.baz, s.bar, s.foo})
+	c.Assert(values, gc.DeepEquals, t.SortedValues())
+}
+
+func (s tagSetSuite) TestSymmetricDifference(c *gc.C) {
+	t1 := names.NewSet(s.foo, s.bar)
+	t2 := names.NewSet(s.foo, s.baz, s.bang)
+
+	c.Assert(t1.SymmetricDifference(t2), gc.DeepEquals, names.NewSet(s.bar, s.baz, s.bang))
+	c.Assert(t2.SymmetricDifference(t1), gc.DeepEquals, names.NewSet(s.bar, s.baz, s.bang))
+	c.Assert(t1.SymmetricDifference(t1), gc.DeepEquals, names.NewSet())
+}
+
+func (s tagSetSuite) TestSubsetSupersetEqual(c *gc.C) {
+	small := names.NewSet(s.foo)
+	big := names.NewSet(s.foo, s.bar)
+	other := names.NewSet(s.baz, s.bang)
+
+	c.Assert(small.IsSubset(big), gc.Equals, true)
+	c.Assert(big.IsSubset(small), gc.Equals, false)
+	c.Assert(small.IsSubset(other), gc.Equals, false)
+	c.Assert(names.NewSet().IsSubset(small), gc.Equals, true)
+	c.Assert(big.IsSuperset(small), gc.Equals, true)
+	c.Assert(small.IsSuperset(big), gc.Equals, false)
+
+	c.Assert(big.Equal(names.NewSet(s.bar, s.foo)), gc.Equals, true)
+	c.Assert(big.Equal(small), gc.Equals, false)
+	c.Assert(other.Equal(big), gc.Equals, false)
+	c.Assert(names.Set(nil).Equal(names.NewSet()), gc.Equals, true)
+}
+
+func (s tagSetSuite) TestClone(c *gc.C) {
+	t := names.NewSet(s.foo, s.bar)
+	clone := t.Clone()
+	c.Assert(clone, gc.DeepEquals, t)
+
+	clone.Add(s.baz)
+	c.Assert(t.Contains(s.baz), gc.Equals, false)
+}
+
+func (s tagSetSuite) TestFilterAndPartition(c *gc.C) {
+	t := names.NewSet(s.foo, s.bar, s.baz, s.bang)
+	isUnit := func(tag names.Tag) bool { return tag.Kind() == names.UnitTagKind }
+
+	c.Assert(t.Filter(isUnit), gc.DeepEquals, names.NewSet(s.foo, s.bar, s.baz))
+
+	units, rest := t.Partition(isUnit)
+	c.Assert(units, gc.DeepEquals, names.NewSet(s.foo, s.bar, s.baz))
+	c.Assert(rest, gc.DeepEquals, names.NewSet(s.bang))
+}
+
+func (s tagSetSuite) TestGroupByKind(c *gc.C) {
+	t := names.NewSet(s.foo, s.bar, s.baz, s.bang)
+	c.Assert(t.GroupByKind(), gc.DeepEquals, map[string]names.Set{
+		names.UnitTagKind:    names.NewSet(s.foo, s.bar, s.baz),
+		names.MachineTagKind: names.NewSet(s.bang),
+	})
+	c.Assert(names.NewSet().GroupByKind(), gc.HasLen, 0)
+}
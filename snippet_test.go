@@ -14,8 +14,16 @@ var snippets = []struct {
 	{"ContainerSnippet", ContainerSnippet},
 	{"MachineSnippet", MachineSnippet},
 	{"NumberSnippet", NumberSnippet},
+	{"UUIDv7Snippet", UUIDv7Snippet},
+	{"StrictUUIDv7Snippet", StrictUUIDv7Snippet},
 	{"ApplicationSnippet", ApplicationSnippet},
 	{"RelationSnippet", RelationSnippet},
+	{"SecretSnippet", SecretSnippet},
+	{"CharmNameSnippet", CharmNameSnippet},
+	{"CharmSeriesSnippet", CharmSeriesSnippet},
+	{"CharmArchitectureSnippet", CharmArchitectureSnippet},
+	{"ResourceNameSnippet", ResourceNameSnippet},
+	{"LinkLayerDeviceNameSnippet", LinkLayerDeviceNameSnippet},
 }
 
 type snippetSuite struct{}
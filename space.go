@@ -6,6 +6,7 @@ package names
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 const (
@@ -35,6 +36,23 @@ func (t SpaceTag) String() string { return t.Kind() + "-" + t.Id() }
 func (t SpaceTag) Kind() string   { return SpaceTagKind }
 func (t SpaceTag) Id() string     { return t.name }
 
+// CreatedAt returns the creation time encoded in the UUIDv7 space ID,
+// and a boolean indicating whether or not the tag has such an ID.
+func (t SpaceTag) CreatedAt() (time.Time, bool) {
+	if t.IsLegacy() {
+		return time.Time{}, false
+	}
+	created, err := UUIDv7Time(t.name)
+	return created, err == nil
+}
+
+// IsLegacy reports whether the tag uses a deprecated Juju 3 space name
+// rather than a UUID space ID. It returns false if t is zero. Use
+// ParseSpaceTagStrict to also reject UUIDs other than UUIDv7.
+func (t SpaceTag) IsLegacy() bool {
+	return t.name != "" && !validSpace.MatchString(t.name)
+}
+
 // NewSpaceTag returns the tag of a space with the given name.
 func NewSpaceTag(name string) SpaceTag {
 	if !IsValidSpace(name) {
@@ -45,13 +63,34 @@ func NewSpaceTag(name string) SpaceTag {
 
 // ParseSpaceTag parses a space tag string.
 func ParseSpaceTag(spaceTag string) (SpaceTag, error) {
-	tag, err := ParseTag(spaceTag)
+	return ParseAs[SpaceTag](spaceTag)
+}
+
+// ParseSpaceTagStrict parses a space tag string, rejecting tags whose ID
+// is not a UUIDv7, such as deprecated Juju 3 space names and UUIDs of
+// other versions.
+func ParseSpaceTagStrict(spaceTag string) (SpaceTag, error) {
+	st, err := ParseSpaceTag(spaceTag)
 	if err != nil {
 		return SpaceTag{}, err
 	}
-	nt, ok := tag.(SpaceTag)
-	if !ok {
+	if !IsValidUUIDv7(st.name) {
 		return SpaceTag{}, invalidTagError(spaceTag, SpaceTagKind)
 	}
-	return nt, nil
+	return st, nil
+}
+
+// TryNewSpaceTag returns the tag for the given name, or an error if name is
+// not a valid space name.
+func TryNewSpaceTag(name string) (SpaceTag, error) {
+	if !IsValidSpace(name) {
+		return SpaceTag{}, fmt.Errorf("%q is not a valid space name", name)
+	}
+	return NewSpaceTag(name), nil
+}
+
+// MustNewSpaceTag returns the tag for the given name.
+// It will panic if name is not a valid space name.
+func MustNewSpaceTag(name string) SpaceTag {
+	return mustTag(TryNewSpaceTag(name))
 }
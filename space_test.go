@@ -83,3 +83,34 @@ func (s *spaceSuite) TestParseSpaceTag(c *gc.C) {
 		c.Check(got, gc.Equals, t.expected)
 	}
 }
+
+func (s *spaceSuite) TestIsLegacy(c *gc.C) {
+	c.Assert(names.NewSpaceTag("alpha").IsLegacy(), gc.Equals, true)
+	c.Assert(names.NewSpaceTag("42").IsLegacy(), gc.Equals, true)
+	c.Assert(names.NewSpaceTag("0195847b-95bb-7ca1-a7ee-2211d802d5b3").IsLegacy(), gc.Equals, false)
+	c.Assert(names.SpaceTag{}.IsLegacy(), gc.Equals, false)
+}
+
+func (s *spaceSuite) TestParseSpaceTagStrict(c *gc.C) {
+	got, err := names.ParseSpaceTagStrict("space-0195847b-95bb-7ca1-a7ee-2211d802d5b3")
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals, names.NewSpaceTag("0195847b-95bb-7ca1-a7ee-2211d802d5b3"))
+
+	_, err = names.ParseSpaceTagStrict("space-alpha")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("space-alpha", names.SpaceTagKind))
+
+	_, err = names.ParseSpaceTagStrict("subnet-0195847b-95bb-7ca1-a7ee-2211d802d5b3")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("subnet-0195847b-95bb-7ca1-a7ee-2211d802d5b3", names.SpaceTagKind))
+}
+
+func (s *spaceSuite) TestUUIDv4(c *gc.C) {
+	const id = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	tag, err := names.ParseSpaceTag("space-" + id)
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag.IsLegacy(), gc.Equals, false)
+	_, ok := tag.CreatedAt()
+	c.Assert(ok, gc.Equals, false)
+
+	_, err = names.ParseSpaceTagStrict("space-" + id)
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("space-"+id, names.SpaceTagKind))
+}
@@ -39,15 +39,7 @@ func NewStorageTag(id string) StorageTag {
 
 // ParseStorageTag parses a storage tag string.
 func ParseStorageTag(s string) (StorageTag, error) {
-	tag, err := ParseTag(s)
-	if err != nil {
-		return StorageTag{}, err
-	}
-	st, ok := tag.(StorageTag)
-	if !ok {
-		return StorageTag{}, invalidTagError(s, StorageTagKind)
-	}
-	return st, nil
+	return ParseAs[StorageTag](s)
 }
 
 // IsValidStorage returns whether id is a valid storage instance ID.
@@ -84,3 +76,18 @@ func storageTagSuffixToId(s string) string {
 	}
 	return s
 }
+
+// TryNewStorageTag returns the tag for the given id, or an error if id is
+// not a valid storage instance ID.
+func TryNewStorageTag(id string) (StorageTag, error) {
+	if !IsValidStorage(id) {
+		return StorageTag{}, fmt.Errorf("%q is not a valid storage instance ID", id)
+	}
+	return NewStorageTag(id), nil
+}
+
+// MustNewStorageTag returns the tag for the given id.
+// It will panic if id is not a valid storage instance ID.
+func MustNewStorageTag(id string) StorageTag {
+	return mustTag(TryNewStorageTag(id))
+}
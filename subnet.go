@@ -6,6 +6,7 @@ package names
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 const SubnetTagKind = "subnet"
@@ -32,6 +33,23 @@ func (t SubnetTag) String() string { return t.Kind() + "-" + t.id }
 func (t SubnetTag) Kind() string   { return SubnetTagKind }
 func (t SubnetTag) Id() string     { return t.id }
 
+// CreatedAt returns the creation time encoded in the UUIDv7 subnet ID,
+// and a boolean indicating whether or not the tag has such an ID.
+func (t SubnetTag) CreatedAt() (time.Time, bool) {
+	if t.IsLegacy() {
+		return time.Time{}, false
+	}
+	created, err := UUIDv7Time(t.id)
+	return created, err == nil
+}
+
+// IsLegacy reports whether the tag uses a deprecated Juju 3 numeric
+// subnet ID rather than a UUID subnet ID. It returns false if t is zero.
+// Use ParseSubnetTagStrict to also reject UUIDs other than UUIDv7.
+func (t SubnetTag) IsLegacy() bool {
+	return t.id != "" && !validSubnet.MatchString(t.id)
+}
+
 // NewSubnetTag returns the tag for subnet with the given ID.
 func NewSubnetTag(id string) SubnetTag {
 	if !IsValidSubnet(id) {
@@ -42,13 +60,34 @@ func NewSubnetTag(id string) SubnetTag {
 
 // ParseSubnetTag parses a subnet tag string.
 func ParseSubnetTag(subnetTag string) (SubnetTag, error) {
-	tag, err := ParseTag(subnetTag)
+	return ParseAs[SubnetTag](subnetTag)
+}
+
+// ParseSubnetTagStrict parses a subnet tag string, rejecting tags whose
+// ID is not a UUIDv7, such as deprecated Juju 3 numeric subnet IDs and
+// UUIDs of other versions.
+func ParseSubnetTagStrict(subnetTag string) (SubnetTag, error) {
+	st, err := ParseSubnetTag(subnetTag)
 	if err != nil {
 		return SubnetTag{}, err
 	}
-	subt, ok := tag.(SubnetTag)
-	if !ok {
+	if !IsValidUUIDv7(st.id) {
 		return SubnetTag{}, invalidTagError(subnetTag, SubnetTagKind)
 	}
-	return subt, nil
+	return st, nil
+}
+
+// TryNewSubnetTag returns the tag for the given id, or an error if id is
+// not a valid subnet ID.
+func TryNewSubnetTag(id string) (SubnetTag, error) {
+	if !IsValidSubnet(id) {
+		return SubnetTag{}, fmt.Errorf("%q is not a valid subnet ID", id)
+	}
+	return NewSubnetTag(id), nil
+}
+
+// MustNewSubnetTag returns the tag for the given id.
+// It will panic if id is not a valid subnet ID.
+func MustNewSubnetTag(id string) SubnetTag {
+	return mustTag(TryNewSubnetTag(id))
 }
@@ -67,3 +67,31 @@ func (s *subnetSuite) TestParseSubnetTag(c *gc.C) {
 		c.Check(got, gc.Equals, t.expected)
 	}
 }
+
+func (s *subnetSuite) TestIsLegacy(c *gc.C) {
+	c.Assert(names.NewSubnetTag("16").IsLegacy(), gc.Equals, true)
+	c.Assert(names.NewSubnetTag("0195847b-95bb-7ca1-a7ee-2211d802d5b3").IsLegacy(), gc.Equals, false)
+	c.Assert(names.SubnetTag{}.IsLegacy(), gc.Equals, false)
+}
+
+func (s *subnetSuite) TestParseSubnetTagStrict(c *gc.C) {
+	got, err := names.ParseSubnetTagStrict("subnet-0195847b-95bb-7ca1-a7ee-2211d802d5b3")
+	c.Assert(err, gc.IsNil)
+	c.Assert(got, gc.Equals, names.NewSubnetTag("0195847b-95bb-7ca1-a7ee-2211d802d5b3"))
+
+	_, err = names.ParseSubnetTagStrict("subnet-16")
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("subnet-16", names.SubnetTagKind))
+}
+
+func (s *subnetSuite) TestUUIDv4(c *gc.C) {
+	const id = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	c.Assert(names.IsValidSubnet(id), gc.Equals, true)
+	tag, err := names.ParseSubnetTag("subnet-" + id)
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag.IsLegacy(), gc.Equals, false)
+	_, ok := tag.CreatedAt()
+	c.Assert(ok, gc.Equals, false)
+
+	_, err = names.ParseSubnetTagStrict("subnet-" + id)
+	c.Assert(err, gc.DeepEquals, names.InvalidTagError("subnet-"+id, names.SubnetTagKind))
+}
@@ -22,6 +22,9 @@ const (
 	// UUIDv7Snippet is a non-compiled regexp that can be composed with other
 	// snippets for validating UUID v7 strings.
 	UUIDv7Snippet = "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"
+	// StrictUUIDv7Snippet is like UUIDv7Snippet, but also checks the version
+	// and the RFC 9562 variant, so UUIDs of other versions are rejected.
+	StrictUUIDv7Snippet = "[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}"
 )
 
 var (
@@ -76,17 +79,67 @@ func TagKind(tag string) (string, error) {
 	return tag[:i], nil
 }
 
+// validKinds reports whether kind is a known tag kind. When adding a kind
+// here, also classify it in IsAgentTag, IsUserTag and IsResourceTag, and
+// describe it in kindCatalogue.
 func validKinds(kind string) bool {
 	switch kind {
 	case UnitTagKind, MachineTagKind, ApplicationTagKind, ApplicationOfferTagKind, EnvironTagKind, UserTagKind,
 		RelationTagKind, ActionTagKind, VolumeTagKind, StorageTagKind, OperationTagKind,
 		FilesystemTagKind, IPAddressTagKind, SpaceTagKind, SubnetTagKind,
-		PayloadTagKind, ModelTagKind, ControllerTagKind, CloudTagKind, CloudCredentialTagKind, CAASModelTagKind:
+		PayloadTagKind, ModelTagKind, ControllerTagKind, CloudTagKind, CloudCredentialTagKind, CAASModelTagKind,
+		SecretTagKind, CharmTagKind, ResourceTagKind, EndpointTagKind, LinkLayerDeviceTagKind,
+		CloudRegionTagKind:
 		return true
 	}
 	return false
 }
 
+// IsAgentTag reports whether tag identifies an agent: a machine, unit or
+// controller agent, or an application agent as run on Kubernetes.
+func IsAgentTag(tag Tag) bool {
+	switch tag.(type) {
+	case MachineTag, UnitTag, ControllerAgentTag, ApplicationTag:
+		return true
+	}
+	return false
+}
+
+// IsUserTag reports whether tag identifies a user.
+func IsUserTag(tag Tag) bool {
+	_, ok := tag.(UserTag)
+	return ok
+}
+
+// IsResourceTag reports whether tag identifies a plain resource, that
+// is anything that is neither an agent nor a user.
+func IsResourceTag(tag Tag) bool {
+	return tag != nil && !IsAgentTag(tag) && !IsUserTag(tag)
+}
+
+// CanLogin reports whether the entity identified by tag may log in to
+// the API. Only agents and users may log in.
+func CanLogin(tag Tag) bool {
+	return IsAgentTag(tag) || IsUserTag(tag)
+}
+
+// AgentEntity returns the tag of the entity that the agent identified by
+// tag runs as. Machine, unit and application agents run as themselves.
+// Controller agents run as the machine with the same number in the
+// controller model. It returns an error if tag is not an agent tag.
+func AgentEntity(tag Tag) (Tag, error) {
+	switch tag := tag.(type) {
+	case MachineTag, UnitTag, ApplicationTag:
+		return tag, nil
+	case ControllerAgentTag:
+		return NewMachineTag(tag.Id()), nil
+	}
+	if tag == nil {
+		return nil, fmt.Errorf("nil tag is not an agent tag")
+	}
+	return nil, fmt.Errorf("%q is not an agent tag", tag.String())
+}
+
 func splitTag(tag string) (string, string, error) {
 	kind, err := TagKind(tag)
 	if err != nil {
@@ -146,7 +199,8 @@ func ParseTag(tag string) (Tag, error) {
 			return NewControllerTag(id), nil
 		}
 		if IsValidControllerAgent(id) {
-			return NewControllerAgentTag(id), nil
+			// NewControllerAgentTag panics on ids too large for an int.
+			return ControllerAgentTag{id: id}, nil
 		}
 		return nil, invalidTagError(tag, kind)
 
@@ -224,11 +278,74 @@ func ParseTag(tag string) (Tag, error) {
 			return nil, invalidTagError(tag, kind)
 		}
 		return NewCAASModelTag(id), nil
+	case SecretTagKind:
+		if !IsValidSecret(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewSecretTag(id), nil
+	case CharmTagKind:
+		id = charmTagSuffixToId(id)
+		if !IsValidCharm(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewCharmTag(id), nil
+	case ResourceTagKind:
+		id = resourceTagSuffixToId(id)
+		if !IsValidResource(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewResourceTag(id), nil
+	case EndpointTagKind:
+		id = endpointTagSuffixToId(id)
+		if !IsValidEndpoint(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewEndpointTag(id), nil
+	case LinkLayerDeviceTagKind:
+		id = linkLayerDeviceTagSuffixToId(id)
+		if !IsValidLinkLayerDevice(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewLinkLayerDeviceTag(id), nil
+	case CloudRegionTagKind:
+		id = cloudRegionTagSuffixToId(id)
+		if !IsValidCloudRegion(id) {
+			return nil, invalidTagError(tag, kind)
+		}
+		return NewCloudRegionTag(id), nil
 	default:
 		return nil, invalidTagError(tag, "")
 	}
 }
 
+// ParseAs parses a string representation into a tag of type T, returning
+// an error if the string is not a valid tag of that kind.
+func ParseAs[T Tag](tag string) (T, error) {
+	var zero T
+	t, err := ParseTag(tag)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := t.(T)
+	if !ok {
+		kind := ""
+		if any(zero) != nil {
+			kind = zero.Kind()
+		}
+		return zero, invalidTagError(tag, kind)
+	}
+	return typed, nil
+}
+
+// mustTag panics if err is not nil and returns tag otherwise. It
+// backs the MustNew* constructors.
+func mustTag[T Tag](tag T, err error) T {
+	if err != nil {
+		panic(err.Error())
+	}
+	return tag
+}
+
 func invalidTagError(tag, kind string) error {
 	if kind != "" {
 		return fmt.Errorf("%q is not a valid %s tag", tag, kind)
@@ -51,6 +51,12 @@ var tagKindTests = []struct {
 	{tag: "cloudcred", err: `"cloudcred" is not a valid tag`},
 	{tag: "cloudcred-aws_admin_foo", kind: names.CloudCredentialTagKind},
 	{tag: "caasmodel-57", kind: names.CAASModelTagKind},
+	{tag: "secret-9m4e2mr0ui3e8a215n4g", kind: names.SecretTagKind},
+	{tag: "charm-ch_amd64_jammy_mysql-42", kind: names.CharmTagKind},
+	{tag: "resource-mysql-router.mysql-image", kind: names.ResourceTagKind},
+	{tag: "endpoint-mysql-router.db-router", kind: names.EndpointTagKind},
+	{tag: "linklayerdevice-0-lxd-1#eth0", kind: names.LinkLayerDeviceTagKind},
+	{tag: "cloudregion-aws_us-east-1", kind: names.CloudRegionTagKind},
 	{tag: "controller-f47ac10b-58cc-4372-a567-0e02b2c3d479", kind: names.ControllerTagKind},
 	{tag: "controller-123", kind: names.ControllerAgentTagKind},
 }
@@ -277,6 +283,76 @@ var parseTagTests = []struct {
 	expectKind: names.ControllerTagKind,
 	expectType: names.ControllerTag{},
 	resultErr:  `"controller-invalid" is not a valid controller tag`,
+}, {
+	tag:        "secret-9m4e2mr0ui3e8a215n4g",
+	expectKind: names.SecretTagKind,
+	expectType: names.SecretTag{},
+	resultId:   "9m4e2mr0ui3e8a215n4g",
+}, {
+	tag:        "secret-9m4e2mr0ui3e8a215n4z",
+	expectKind: names.SecretTagKind,
+	expectType: names.SecretTag{},
+	resultErr:  `"secret-9m4e2mr0ui3e8a215n4z" is not a valid secret tag`,
+}, {
+	tag:        "charm-ch_amd64_jammy_mysql-42",
+	expectKind: names.CharmTagKind,
+	expectType: names.CharmTag{},
+	resultId:   "ch:amd64/jammy/mysql-42",
+}, {
+	tag:        "charm-local_foo-bar",
+	expectKind: names.CharmTagKind,
+	expectType: names.CharmTag{},
+	resultId:   "local:foo-bar",
+}, {
+	tag:        "charm-mysql",
+	expectKind: names.CharmTagKind,
+	expectType: names.CharmTag{},
+	resultErr:  `"charm-mysql" is not a valid charm tag`,
+}, {
+	tag:        "resource-mysql-router.mysql-image",
+	expectKind: names.ResourceTagKind,
+	expectType: names.ResourceTag{},
+	resultId:   "mysql-router/mysql-image",
+}, {
+	tag:        "resource-mysql-router.mysql-image.3",
+	expectKind: names.ResourceTagKind,
+	expectType: names.ResourceTag{},
+	resultId:   "mysql-router/mysql-image/3",
+}, {
+	tag:        "resource-mysql-router-mysql-image",
+	expectKind: names.ResourceTagKind,
+	expectType: names.ResourceTag{},
+	resultErr:  `"resource-mysql-router-mysql-image" is not a valid resource tag`,
+}, {
+	tag:        "endpoint-mysql-router.db-router",
+	expectKind: names.EndpointTagKind,
+	expectType: names.EndpointTag{},
+	resultId:   "mysql-router:db-router",
+}, {
+	tag:        "endpoint-mysql-router",
+	expectKind: names.EndpointTagKind,
+	expectType: names.EndpointTag{},
+	resultErr:  `"endpoint-mysql-router" is not a valid endpoint tag`,
+}, {
+	tag:        "linklayerdevice-0-lxd-1#br-eth0",
+	expectKind: names.LinkLayerDeviceTagKind,
+	expectType: names.LinkLayerDeviceTag{},
+	resultId:   "0/lxd/1/br-eth0",
+}, {
+	tag:        "linklayerdevice-0-lxd-1-eth0",
+	expectKind: names.LinkLayerDeviceTagKind,
+	expectType: names.LinkLayerDeviceTag{},
+	resultErr:  `"linklayerdevice-0-lxd-1-eth0" is not a valid linklayerdevice tag`,
+}, {
+	tag:        "cloudregion-my%5fcloud_us-east-1",
+	expectKind: names.CloudRegionTagKind,
+	expectType: names.CloudRegionTag{},
+	resultId:   "my_cloud/us-east-1",
+}, {
+	tag:        "cloudregion-aws",
+	expectKind: names.CloudRegionTagKind,
+	expectType: names.CloudRegionTag{},
+	resultErr:  `"cloudregion-aws" is not a valid cloudregion tag`,
 }}
 
 var makeTag = map[string]func(string) names.Tag{
@@ -299,6 +375,12 @@ var makeTag = map[string]func(string) names.Tag{
 	names.CloudTagKind:            func(tag string) names.Tag { return names.NewCloudTag(tag) },
 	names.CloudCredentialTagKind:  func(tag string) names.Tag { return names.NewCloudCredentialTag(tag) },
 	names.CAASModelTagKind:        func(tag string) names.Tag { return names.NewCAASModelTag(tag) },
+	names.SecretTagKind:           func(tag string) names.Tag { return names.NewSecretTag(tag) },
+	names.CharmTagKind:            func(tag string) names.Tag { return names.NewCharmTag(tag) },
+	names.ResourceTagKind:         func(tag string) names.Tag { return names.NewResourceTag(tag) },
+	names.EndpointTagKind:         func(tag string) names.Tag { return names.NewEndpointTag(tag) },
+	names.LinkLayerDeviceTagKind:  func(tag string) names.Tag { return names.NewLinkLayerDeviceTag(tag) },
+	names.CloudRegionTagKind:      func(tag string) names.Tag { return names.NewCloudRegionTag(tag) },
 	names.ControllerTagKind: func(tag string) names.Tag {
 		_, err := strconv.Atoi(tag)
 		if err == nil {
@@ -373,3 +455,120 @@ func (*tagSuite) TestReadableString(c *gc.C) {
 		c.Assert(resultStr, gc.Equals, test.result)
 	}
 }
+
+var tagClassificationTests = []struct {
+	tag      names.Tag
+	agent    bool
+	user     bool
+	resource bool
+	runsAs   names.Tag
+}{
+	{tag: names.NewMachineTag("0"), agent: true, runsAs: names.NewMachineTag("0")},
+	{tag: names.NewMachineTag("0/lxd/1"), agent: true, runsAs: names.NewMachineTag("0/lxd/1")},
+	{tag: names.NewUnitTag("mysql/0"), agent: true, runsAs: names.NewUnitTag("mysql/0")},
+	{tag: names.NewApplicationTag("mysql"), agent: true, runsAs: names.NewApplicationTag("mysql")},
+	{tag: names.NewControllerAgentTag("2"), agent: true, runsAs: names.NewMachineTag("2")},
+	{tag: names.NewUserTag("bob@external"), user: true},
+	{tag: names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), resource: true},
+	{tag: names.NewControllerTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), resource: true},
+	{tag: names.NewCloudTag("aws"), resource: true},
+	{tag: names.NewVolumeTag("0/1"), resource: true},
+	{tag: nil},
+}
+
+func (*tagSuite) TestClassification(c *gc.C) {
+	for i, test := range tagClassificationTests {
+		c.Logf("test %d: %v", i, test.tag)
+		c.Check(names.IsAgentTag(test.tag), gc.Equals, test.agent)
+		c.Check(names.IsUserTag(test.tag), gc.Equals, test.user)
+		c.Check(names.IsResourceTag(test.tag), gc.Equals, test.resource)
+		c.Check(names.CanLogin(test.tag), gc.Equals, test.agent || test.user)
+
+		entity, err := names.AgentEntity(test.tag)
+		if test.agent {
+			c.Check(err, gc.IsNil)
+			c.Check(entity, gc.Equals, test.runsAs)
+		} else {
+			c.Check(err, gc.ErrorMatches, `.* is not an agent tag`)
+			c.Check(entity, gc.IsNil)
+		}
+	}
+}
+
+func (*tagSuite) TestParseAs(c *gc.C) {
+	unit, err := names.ParseAs[names.UnitTag]("unit-wordpress-0")
+	c.Assert(err, gc.IsNil)
+	c.Assert(unit, gc.Equals, names.NewUnitTag("wordpress/0"))
+
+	_, err = names.ParseAs[names.UnitTag]("machine-0")
+	c.Assert(err, gc.ErrorMatches, `"machine-0" is not a valid unit tag`)
+
+	_, err = names.ParseAs[names.UnitTag]("unit-")
+	c.Assert(err, gc.ErrorMatches, `"unit-" is not a valid unit tag`)
+
+	_, err = names.ParseAs[names.ActionReceiver]("model-f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.ErrorMatches, `"model-f47ac10b-58cc-4372-a567-0e02b2c3d479" is not a valid tag`)
+
+	tag, err := names.ParseAs[names.Tag]("machine-0")
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag, gc.Equals, names.NewMachineTag("0"))
+}
+
+func tryNew[T names.Tag](f func(string) (T, error)) func(string) (names.Tag, error) {
+	return func(id string) (names.Tag, error) { return f(id) }
+}
+
+func mustNew[T names.Tag](f func(string) T) func(string) names.Tag {
+	return func(id string) names.Tag { return f(id) }
+}
+
+var tryNewTests = []struct {
+	try     func(string) (names.Tag, error)
+	must    func(string) names.Tag
+	valid   string
+	invalid string
+	err     string
+}{
+	{tryNew(names.TryNewActionTag), mustNew(names.MustNewActionTag), "1", "foo", `invalid action id "foo"`},
+	{tryNew(names.TryNewApplicationTag), mustNew(names.MustNewApplicationTag), "wordpress", "wordpress/0", `"wordpress/0" is not a valid application name`},
+	{tryNew(names.TryNewCharmTag), mustNew(names.MustNewCharmTag), "ch:amd64/jammy/mysql-42", "mysql", `.*`},
+	{tryNew(names.TryNewCloudTag), mustNew(names.MustNewCloudTag), "aws", "aws/foo", `"aws/foo" is not a valid cloud ID`},
+	{tryNew(names.TryNewCloudCredentialTag), mustNew(names.MustNewCloudCredentialTag), "aws/bob/foo", "aws/bob", `"aws/bob" is not a valid cloud credential ID`},
+	{tryNew(names.TryNewCloudRegionTag), mustNew(names.MustNewCloudRegionTag), "aws/us-east-1", "aws", `"aws" is not a valid cloud region ID`},
+	{tryNew(names.TryNewControllerAgentTag), mustNew(names.MustNewControllerAgentTag), "0", "x", `"x" is not a valid controller agent id`},
+	{tryNew(names.TryNewEndpointTag), mustNew(names.MustNewEndpointTag), "mysql:db", "mysql", `"mysql" is not a valid endpoint id`},
+	{tryNew(names.TryNewFilesystemTag), mustNew(names.MustNewFilesystemTag), "0/0", "a", `"a" is not a valid filesystem id`},
+	{tryNew(names.TryNewIPAddressTag), mustNew(names.MustNewIPAddressTag), "f47ac10b-58cc-4372-a567-0e02b2c3d479", "1.2.3.4", `"1.2.3.4" is not a valid IP address id`},
+	{tryNew(names.TryNewLinkLayerDeviceTag), mustNew(names.MustNewLinkLayerDeviceTag), "0/eth0", "eth0", `"eth0" is not a valid link-layer device id`},
+	{tryNew(names.TryNewMachineTag), mustNew(names.MustNewMachineTag), "0/lxd/1", "0/", `"0/" is not a valid machine id`},
+	{tryNew(names.TryNewOperationTag), mustNew(names.MustNewOperationTag), "1", "x", `invalid operation id "x"`},
+	{tryNew(names.TryNewPayloadTag), mustNew(names.MustNewPayloadTag), "f47ac10b-58cc-4372-a567-0e02b2c3d479", "a/b", `"a/b" is not a valid payload id`},
+	{tryNew(names.TryNewRelationTag), mustNew(names.MustNewRelationTag), "wordpress:db mysql:server", "wordpress", `"wordpress" is not a valid relation key`},
+	{tryNew(names.TryNewResourceTag), mustNew(names.MustNewResourceTag), "mysql/data", "mysql", `"mysql" is not a valid resource id`},
+	{tryNew(names.TryNewSecretTag), mustNew(names.MustNewSecretTag), "9m4e2mr0ui3e8a215n4g", "x", `"x" is not a valid secret id`},
+	{tryNew(names.TryNewSpaceTag), mustNew(names.MustNewSpaceTag), "dmz", "a b", `"a b" is not a valid space name`},
+	{tryNew(names.TryNewStorageTag), mustNew(names.MustNewStorageTag), "data/0", "data", `"data" is not a valid storage instance ID`},
+	{tryNew(names.TryNewSubnetTag), mustNew(names.MustNewSubnetTag), "16", "x", `"x" is not a valid subnet ID`},
+	{tryNew(names.TryNewUnitTag), mustNew(names.MustNewUnitTag), "wordpress/0", "wordpress", `"wordpress" is not a valid unit name`},
+	{tryNew(names.TryNewUserTag), mustNew(names.MustNewUserTag), "bob@remote", "bob@", `invalid user tag "bob@"`},
+	{tryNew(names.TryNewLocalUserTag), mustNew(names.MustNewLocalUserTag), "bob", "bob@remote", `invalid user name "bob@remote"`},
+	{tryNew(names.TryNewVolumeTag), mustNew(names.MustNewVolumeTag), "0", "a", `"a" is not a valid volume ID`},
+	{tryNew(names.TryNewModelTag), mustNew(names.MustNewModelTag), "f47ac10b-58cc-4372-a567-0e02b2c3d479", "x", `"x" is not a valid model UUID`},
+}
+
+func (*tagSuite) TestTryNewAndMustNew(c *gc.C) {
+	for i, t := range tryNewTests {
+		c.Logf("test %d: %q / %q", i, t.valid, t.invalid)
+		tag, err := t.try(t.valid)
+		c.Check(err, gc.IsNil)
+		c.Check(t.must(t.valid), gc.Equals, tag)
+
+		parsed, err := names.ParseTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+
+		_, err = t.try(t.invalid)
+		c.Check(err, gc.ErrorMatches, t.err)
+		c.Check(func() { t.must(t.invalid) }, gc.PanicMatches, t.err)
+	}
+}
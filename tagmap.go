@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// TagMap is a map keyed by tags. Its iteration methods visit entries in
+// the same order as Set.SortedValues, so output built from a TagMap is
+// deterministic. It is marshalled to JSON as an object keyed by tag
+// strings.
+type TagMap[V any] map[Tag]V
+
+// NewTagMap returns an empty TagMap.
+func NewTagMap[V any]() TagMap[V] {
+	return make(TagMap[V])
+}
+
+// Len returns the number of entries in the map.
+func (m TagMap[V]) Len() int {
+	return len(m)
+}
+
+// Get returns the value stored for tag, and whether there was one.
+func (m TagMap[V]) Get(tag Tag) (V, bool) {
+	v, ok := m[tag]
+	return v, ok
+}
+
+// Set stores value for tag.
+func (m TagMap[V]) Set(tag Tag, value V) {
+	if m == nil {
+		panic("uninitalised tag map")
+	}
+	m[tag] = value
+}
+
+// Delete removes the entry for tag. If there is none, Delete silently
+// succeeds.
+func (m TagMap[V]) Delete(tag Tag) {
+	delete(m, tag)
+}
+
+// Keys returns the tags in the map as a Set.
+func (m TagMap[V]) Keys() Set {
+	result := make(Set, len(m))
+	for tag := range m {
+		result.Add(tag)
+	}
+	return result
+}
+
+// SortedKeys returns the tags in the map, in order.
+func (m TagMap[V]) SortedKeys() []Tag {
+	result := make([]Tag, 0, len(m))
+	for tag := range m {
+		result = append(result, tag)
+	}
+	sortTags(result)
+	return result
+}
+
+// Range calls f for each entry in the map, in tag order, stopping early
+// if f returns false.
+func (m TagMap[V]) Range(f func(tag Tag, value V) bool) {
+	for _, tag := range m.SortedKeys() {
+		if !f(tag, m[tag]) {
+			return
+		}
+	}
+}
+
+// OfKind returns a new map holding the entries whose tags are of one of
+// the given kinds.
+func (m TagMap[V]) OfKind(kinds ...string) TagMap[V] {
+	result := make(TagMap[V])
+	for tag, value := range m {
+		for _, kind := range kinds {
+			if tag.Kind() == kind {
+				result[tag] = value
+				break
+			}
+		}
+	}
+	return result
+}
+
+// MarshalJSON implements json.Marshaler, using the tags' strings as
+// object keys.
+func (m TagMap[V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	raw := make(map[string]V, len(m))
+	for tag, value := range m {
+		raw[tag.String()] = value
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing object keys with
+// ParseTag.
+func (m *TagMap[V]) UnmarshalJSON(data []byte) error {
+	var raw map[string]V
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errors.Trace(err)
+	}
+	if raw == nil {
+		*m = nil
+		return nil
+	}
+	result := make(TagMap[V], len(raw))
+	for key, value := range raw {
+		tag, err := ParseTag(key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		result[tag] = value
+	}
+	*m = result
+	return nil
+}
+
+// sortTags sorts tags by their string form, the order used by
+// Set.SortedValues. Each tag's string is computed only once.
+func sortTags(tags []Tag) {
+	keyed := make([]struct {
+		key string
+		tag Tag
+	}, len(tags))
+	for i, tag := range tags {
+		keyed[i].key = tag.String()
+		keyed[i].tag = tag
+	}
+	sort.Slice(keyed, func(i, j int) bool {
+		return keyed[i].key < keyed[j].key
+	})
+	for i := range keyed {
+		tags[i] = keyed[i].tag
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"encoding/json"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type tagMapSuite struct{}
+
+var _ = gc.Suite(&tagMapSuite{})
+
+var (
+	mapWordpress0 = names.NewUnitTag("wordpress/0")
+	mapWordpress1 = names.NewUnitTag("wordpress/1")
+	mapMachine0   = names.NewMachineTag("0")
+	mapMysql      = names.NewApplicationTag("mysql")
+)
+
+func (s *tagMapSuite) newMap() names.TagMap[string] {
+	m := names.NewTagMap[string]()
+	m.Set(mapWordpress1, "started")
+	m.Set(mapMachine0, "running")
+	m.Set(mapWordpress0, "idle")
+	m.Set(mapMysql, "active")
+	return m
+}
+
+func (s *tagMapSuite) TestGetSetDelete(c *gc.C) {
+	m := s.newMap()
+	c.Assert(m.Len(), gc.Equals, 4)
+
+	v, ok := m.Get(mapWordpress0)
+	c.Assert(ok, gc.Equals, true)
+	c.Assert(v, gc.Equals, "idle")
+
+	m.Set(mapWordpress0, "executing")
+	v, _ = m.Get(mapWordpress0)
+	c.Assert(v, gc.Equals, "executing")
+
+	m.Delete(mapWordpress0)
+	m.Delete(names.NewUnitTag("missing/0"))
+	_, ok = m.Get(mapWordpress0)
+	c.Assert(ok, gc.Equals, false)
+	c.Assert(m.Len(), gc.Equals, 3)
+}
+
+func (s *tagMapSuite) TestUninitializedPanics(c *gc.C) {
+	var m names.TagMap[int]
+	_, ok := m.Get(mapMachine0)
+	c.Assert(ok, gc.Equals, false)
+	c.Assert(func() { m.Set(mapMachine0, 1) }, gc.PanicMatches, "uninitalised tag map")
+}
+
+func (s *tagMapSuite) TestKeys(c *gc.C) {
+	m := s.newMap()
+	c.Assert(m.Keys(), gc.DeepEquals, names.NewSet(mapWordpress0, mapWordpress1, mapMachine0, mapMysql))
+	c.Assert(m.SortedKeys(), gc.DeepEquals, m.Keys().SortedValues())
+}
+
+func (s *tagMapSuite) TestRange(c *gc.C) {
+	m := s.newMap()
+	var tags []names.Tag
+	var values []string
+	m.Range(func(tag names.Tag, value string) bool {
+		tags = append(tags, tag)
+		values = append(values, value)
+		return true
+	})
+	c.Assert(tags, gc.DeepEquals, []names.Tag{mapMysql, mapMachine0, mapWordpress0, mapWordpress1})
+	c.Assert(values, gc.DeepEquals, []string{"active", "running", "idle", "started"})
+
+	tags = nil
+	m.Range(func(tag names.Tag, value string) bool {
+		tags = append(tags, tag)
+		return len(tags) < 2
+	})
+	c.Assert(tags, gc.DeepEquals, []names.Tag{mapMysql, mapMachine0})
+}
+
+func (s *tagMapSuite) TestOfKind(c *gc.C) {
+	m := s.newMap()
+	c.Assert(m.OfKind(names.UnitTagKind), gc.DeepEquals, names.TagMap[string]{
+		mapWordpress0: "idle",
+		mapWordpress1: "started",
+	})
+	c.Assert(m.OfKind(names.MachineTagKind, names.ApplicationTagKind), gc.DeepEquals, names.TagMap[string]{
+		mapMachine0: "running",
+		mapMysql:    "active",
+	})
+	c.Assert(m.OfKind(names.ModelTagKind), gc.HasLen, 0)
+}
+
+func (s *tagMapSuite) TestMarshalJSON(c *gc.C) {
+	data, err := json.Marshal(s.newMap())
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(data), gc.Equals,
+		`{"application-mysql":"active","machine-0":"running","unit-wordpress-0":"idle","unit-wordpress-1":"started"}`)
+
+	var m names.TagMap[string]
+	c.Assert(json.Unmarshal(data, &m), gc.IsNil)
+	c.Assert(m, gc.DeepEquals, s.newMap())
+
+	data, err = json.Marshal(names.TagMap[int](nil))
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(data), gc.Equals, "null")
+	c.Assert(json.Unmarshal(data, &m), gc.IsNil)
+	c.Assert(m, gc.IsNil)
+}
+
+func (s *tagMapSuite) TestMarshalJSONNested(c *gc.C) {
+	type status struct {
+		Units names.TagMap[int] `json:"units"`
+	}
+	in := status{Units: names.TagMap[int]{mapWordpress0: 1}}
+	data, err := json.Marshal(in)
+	c.Assert(err, gc.IsNil)
+	c.Assert(string(data), gc.Equals, `{"units":{"unit-wordpress-0":1}}`)
+
+	var out status
+	c.Assert(json.Unmarshal(data, &out), gc.IsNil)
+	c.Assert(out, gc.DeepEquals, in)
+}
+
+func (s *tagMapSuite) TestUnmarshalJSONInvalidTag(c *gc.C) {
+	var m names.TagMap[int]
+	err := json.Unmarshal([]byte(`{"unit-wordpress":1}`), &m)
+	c.Assert(err, gc.ErrorMatches, `"unit-wordpress" is not a valid unit tag`)
+
+	err = json.Unmarshal([]byte(`{"unit-wordpress-0":"x"}`), &m)
+	c.Assert(err, gc.ErrorMatches, `json: cannot unmarshal string into Go .* of type int`)
+}
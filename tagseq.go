@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import "iter"
+
+// TagSeq is a lazily evaluated sequence of tags. Its methods return new
+// sequences that do no work until they are iterated, or consumed with
+// Collect, Slice or Count. A TagSeq may be ranged over directly.
+//
+// For example, to find the first ten started units of an application
+// in a large set without copying it:
+//
+//	units := set.Lazy().
+//		OfKind(names.UnitTagKind).
+//		Filter(isStarted).
+//		Take(10).
+//		Slice()
+type TagSeq iter.Seq[Tag]
+
+// Filter returns a sequence of the tags in s for which keep returns true.
+func (s TagSeq) Filter(keep func(Tag) bool) TagSeq {
+	return func(yield func(Tag) bool) {
+		for tag := range s {
+			if keep(tag) && !yield(tag) {
+				return
+			}
+		}
+	}
+}
+
+// OfKind returns a sequence of the tags in s that are of one of the
+// given kinds.
+func (s TagSeq) OfKind(kinds ...string) TagSeq {
+	return s.Filter(func(tag Tag) bool {
+		for _, kind := range kinds {
+			if tag.Kind() == kind {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Map returns a sequence of the results of calling f on each tag in s.
+func (s TagSeq) Map(f func(Tag) Tag) TagSeq {
+	return func(yield func(Tag) bool) {
+		for tag := range s {
+			if !yield(f(tag)) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of at most the first n tags in s.
+func (s TagSeq) Take(n int) TagSeq {
+	return func(yield func(Tag) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for tag := range s {
+			if !yield(tag) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a new Set holding the tags in s.
+func (s TagSeq) Collect() Set {
+	result := make(Set)
+	for tag := range s {
+		result[tag] = true
+	}
+	return result
+}
+
+// Slice returns the tags in s, in the order they are produced.
+func (s TagSeq) Slice() []Tag {
+	var result []Tag
+	for tag := range s {
+		result = append(result, tag)
+	}
+	return result
+}
+
+// Count returns the number of tags in s.
+func (s TagSeq) Count() int {
+	n := 0
+	for range s {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type tagSeqSuite struct{}
+
+var _ = gc.Suite(&tagSeqSuite{})
+
+func (s *tagSeqSuite) bigSet() names.Set {
+	set := names.NewSet(names.NewMachineTag("0"), names.NewApplicationTag("wordpress"))
+	for i := 0; i < 1000; i++ {
+		set.Add(names.NewUnitTag(fmt.Sprintf("wordpress/%d", i)))
+	}
+	return set
+}
+
+func (s *tagSeqSuite) TestPipeline(c *gc.C) {
+	set := s.bigSet()
+	even := func(tag names.Tag) bool { return tag.(names.UnitTag).Number()%2 == 0 }
+
+	units := set.Lazy().OfKind(names.UnitTagKind).Filter(even).Collect()
+	c.Assert(units.Size(), gc.Equals, 500)
+	c.Assert(set.Lazy().OfKind(names.MachineTagKind, names.ApplicationTagKind).Count(), gc.Equals, 2)
+	c.Assert(set.Lazy().Take(10).Slice(), gc.HasLen, 10)
+	c.Assert(set.Lazy().Take(0).Count(), gc.Equals, 0)
+	c.Assert(names.NewSet().Lazy().Take(5).Slice(), gc.HasLen, 0)
+}
+
+func (s *tagSeqSuite) TestLazy(c *gc.C) {
+	set := s.bigSet()
+	calls := 0
+	seq := set.Lazy().Filter(func(tag names.Tag) bool {
+		calls++
+		return tag.Kind() == names.UnitTagKind
+	})
+	c.Assert(calls, gc.Equals, 0)
+
+	c.Assert(seq.Take(3).Count(), gc.Equals, 3)
+	c.Assert(calls < set.Size(), gc.Equals, true)
+}
+
+func (s *tagSeqSuite) TestMap(c *gc.C) {
+	set := names.NewSet(names.NewUnitTag("wordpress/0"), names.NewUnitTag("mysql/1"))
+	apps := set.Lazy().Map(func(tag names.Tag) names.Tag {
+		return tag.(names.UnitTag).Application()
+	}).Collect()
+	c.Assert(apps, gc.DeepEquals, names.NewSet(names.NewApplicationTag("wordpress"), names.NewApplicationTag("mysql")))
+}
+
+func (s *tagSeqSuite) TestRange(c *gc.C) {
+	set := names.NewSet(names.NewMachineTag("0"), names.NewMachineTag("1"))
+	seen := names.NewSet()
+	for tag := range set.Lazy() {
+		seen.Add(tag)
+	}
+	c.Assert(seen, gc.DeepEquals, set)
+}
@@ -42,6 +42,15 @@ func (t UnitTag) Number() int {
 	return 0
 }
 
+// Application returns the tag of the application the unit belongs to.
+// The zero ApplicationTag is returned for the zero UnitTag.
+func (t UnitTag) Application() ApplicationTag {
+	if i := strings.LastIndex(t.name, "-"); i > 0 {
+		return NewApplicationTag(t.name[:i])
+	}
+	return ApplicationTag{}
+}
+
 // NewUnitTag returns the tag for the unit with the given name.
 // It will panic if the given unit name is not valid.
 func NewUnitTag(unitName string) UnitTag {
@@ -54,15 +63,7 @@ func NewUnitTag(unitName string) UnitTag {
 
 // ParseUnitTag parses a unit tag string.
 func ParseUnitTag(unitTag string) (UnitTag, error) {
-	tag, err := ParseTag(unitTag)
-	if err != nil {
-		return UnitTag{}, err
-	}
-	ut, ok := tag.(UnitTag)
-	if !ok {
-		return UnitTag{}, invalidTagError(unitTag, UnitTagKind)
-	}
-	return ut, nil
+	return ParseAs[UnitTag](unitTag)
 }
 
 // IsValidUnit returns whether name is a valid unit name.
@@ -96,6 +97,27 @@ func UnitNumber(unitName string) (int, error) {
 	return num, nil
 }
 
+// NextUnit returns the tag for the next unit of the given application,
+// numbered one above the highest numbered unit of that application found
+// in existing. Tags of other kinds or other applications are ignored.
+// It returns an error if app is not a valid application.
+func NextUnit(app ApplicationTag, existing Set) (UnitTag, error) {
+	if !IsValidApplication(app.Id()) {
+		return UnitTag{}, fmt.Errorf("%q is not a valid application name", app.Id())
+	}
+	next := 0
+	for tag := range existing {
+		ut, ok := tag.(UnitTag)
+		if !ok || ut.Application() != app {
+			continue
+		}
+		if n := ut.Number(); n >= next {
+			next = n + 1
+		}
+	}
+	return NewUnitTag(fmt.Sprintf("%s/%d", app.Id(), next)), nil
+}
+
 func tagFromUnitName(unitName string) (UnitTag, bool) {
 	// Replace only the last "/" with "-".
 	i := strings.LastIndex(unitName, "/")
@@ -143,3 +165,18 @@ func (t UnitTag) ShortenedString(maxLength int) (string, error) {
 	}
 	return "unit-" + name + hashString + "-" + id, nil
 }
+
+// TryNewUnitTag returns the tag for the given name, or an error if name is
+// not a valid unit name.
+func TryNewUnitTag(name string) (UnitTag, error) {
+	if !IsValidUnit(name) {
+		return UnitTag{}, fmt.Errorf("%q is not a valid unit name", name)
+	}
+	return NewUnitTag(name), nil
+}
+
+// MustNewUnitTag returns the tag for the given name.
+// It will panic if name is not a valid unit name.
+func MustNewUnitTag(name string) UnitTag {
+	return mustTag(TryNewUnitTag(name))
+}
@@ -63,6 +63,33 @@ func (s *unitSuite) TestNumber(c *gc.C) {
 	c.Assert(u.Number(), gc.Equals, 5)
 }
 
+func (s *unitSuite) TestApplication(c *gc.C) {
+	c.Assert(names.UnitTag{}.Application(), gc.Equals, names.ApplicationTag{})
+	c.Assert(names.NewUnitTag("wordpress/3").Application(), gc.Equals, names.NewApplicationTag("wordpress"))
+	c.Assert(names.NewUnitTag("foo-bar-t4/5").Application(), gc.Equals, names.NewApplicationTag("foo-bar-t4"))
+}
+
+func (s *unitSuite) TestNextUnit(c *gc.C) {
+	app := names.NewApplicationTag("foo-bar")
+	tag, err := names.NextUnit(app, names.NewSet())
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag, gc.Equals, names.NewUnitTag("foo-bar/0"))
+
+	existing := names.NewSet(
+		names.NewUnitTag("foo-bar/0"),
+		names.NewUnitTag("foo-bar/7"),
+		names.NewUnitTag("foo/9"),
+		names.NewUnitTag("foo-bar-baz/12"),
+		names.NewMachineTag("20"),
+	)
+	tag, err = names.NextUnit(app, existing)
+	c.Assert(err, gc.IsNil)
+	c.Assert(tag, gc.Equals, names.NewUnitTag("foo-bar/8"))
+
+	_, err = names.NextUnit(names.NewApplicationTag("foo-1"), existing)
+	c.Assert(err, gc.ErrorMatches, `"foo-1" is not a valid application name`)
+}
+
 func (s *applicationSuite) TestUnitApplication(c *gc.C) {
 	for i, test := range unitNameTests {
 		c.Logf("test %d: %q", i, test.pattern)
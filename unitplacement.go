@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnitPlacement records that a subordinate unit is deployed alongside a
+// principal unit, and optionally the machine hosting both.
+//
+// The string form is "<subordinate>:<principal>" or
+// "<subordinate>:<principal>:<machine>", for example
+// "telegraf/0:mysql/1:0/lxd/2". The separator cannot appear in unit or
+// machine ids, so the form always round-trips.
+type UnitPlacement struct {
+	Subordinate UnitTag
+	Principal   UnitTag
+
+	// Machine is the zero MachineTag if the hosting machine is unknown.
+	Machine MachineTag
+}
+
+// NewUnitPlacement returns the placement of a subordinate unit on the
+// given principal unit. It returns an error if either unit is the zero
+// tag, or if both belong to the same application.
+func NewUnitPlacement(subordinate, principal UnitTag) (UnitPlacement, error) {
+	p := UnitPlacement{Subordinate: subordinate, Principal: principal}
+	if err := p.Validate(); err != nil {
+		return UnitPlacement{}, err
+	}
+	return p, nil
+}
+
+// OnMachine returns a copy of the placement with the hosting machine set.
+func (p UnitPlacement) OnMachine(machine MachineTag) UnitPlacement {
+	p.Machine = machine
+	return p
+}
+
+// HasMachine reports whether the hosting machine is known.
+func (p UnitPlacement) HasMachine() bool {
+	return p.Machine != MachineTag{}
+}
+
+// Validate returns an error if the placement is not well formed.
+func (p UnitPlacement) Validate() error {
+	if p.Subordinate == (UnitTag{}) {
+		return fmt.Errorf("unit placement missing subordinate unit")
+	}
+	if p.Principal == (UnitTag{}) {
+		return fmt.Errorf("unit placement missing principal unit")
+	}
+	if p.Subordinate.Application() == p.Principal.Application() {
+		return fmt.Errorf("subordinate unit %q and principal unit %q belong to the same application",
+			p.Subordinate.Id(), p.Principal.Id())
+	}
+	return nil
+}
+
+// String returns the canonical string form of the placement.
+func (p UnitPlacement) String() string {
+	s := p.Subordinate.Id() + ":" + p.Principal.Id()
+	if p.HasMachine() {
+		s += ":" + p.Machine.Id()
+	}
+	return s
+}
+
+// ParseUnitPlacement parses the string form of a unit placement, as
+// produced by UnitPlacement.String.
+func ParseUnitPlacement(s string) (UnitPlacement, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return UnitPlacement{}, fmt.Errorf("%q is not a valid unit placement", s)
+	}
+	if !IsValidUnit(parts[0]) || !IsValidUnit(parts[1]) {
+		return UnitPlacement{}, fmt.Errorf("%q is not a valid unit placement", s)
+	}
+	p, err := NewUnitPlacement(NewUnitTag(parts[0]), NewUnitTag(parts[1]))
+	if err != nil {
+		return UnitPlacement{}, fmt.Errorf("%q is not a valid unit placement: %v", s, err)
+	}
+	if len(parts) == 3 {
+		if !IsValidMachine(parts[2]) {
+			return UnitPlacement{}, fmt.Errorf("%q is not a valid unit placement", s)
+		}
+		p.Machine = NewMachineTag(parts[2])
+	}
+	return p, nil
+}
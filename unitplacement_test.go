@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type unitPlacementSuite struct{}
+
+var _ = gc.Suite(&unitPlacementSuite{})
+
+func (s *unitPlacementSuite) TestNewUnitPlacement(c *gc.C) {
+	p, err := names.NewUnitPlacement(names.NewUnitTag("telegraf/0"), names.NewUnitTag("mysql/1"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p.HasMachine(), jc.IsFalse)
+	c.Assert(p.String(), gc.Equals, "telegraf/0:mysql/1")
+
+	p = p.OnMachine(names.NewMachineTag("0/lxd/2"))
+	c.Assert(p.HasMachine(), jc.IsTrue)
+	c.Assert(p.String(), gc.Equals, "telegraf/0:mysql/1:0/lxd/2")
+}
+
+func (s *unitPlacementSuite) TestNewUnitPlacementInvalid(c *gc.C) {
+	_, err := names.NewUnitPlacement(names.UnitTag{}, names.NewUnitTag("mysql/1"))
+	c.Assert(err, gc.ErrorMatches, "unit placement missing subordinate unit")
+	_, err = names.NewUnitPlacement(names.NewUnitTag("telegraf/0"), names.UnitTag{})
+	c.Assert(err, gc.ErrorMatches, "unit placement missing principal unit")
+	_, err = names.NewUnitPlacement(names.NewUnitTag("mysql/0"), names.NewUnitTag("mysql/1"))
+	c.Assert(err, gc.ErrorMatches, `subordinate unit "mysql/0" and principal unit "mysql/1" belong to the same application`)
+}
+
+var parseUnitPlacementTests = []struct {
+	placement   string
+	subordinate string
+	principal   string
+	machine     string
+	err         string
+}{
+	{placement: "telegraf/0:mysql/1", subordinate: "telegraf/0", principal: "mysql/1"},
+	{placement: "telegraf/0:mysql/1:3", subordinate: "telegraf/0", principal: "mysql/1", machine: "3"},
+	{placement: "ntp-agent/4:foo-bar/12:0/lxd/2", subordinate: "ntp-agent/4", principal: "foo-bar/12", machine: "0/lxd/2"},
+	{placement: "", err: `"" is not a valid unit placement`},
+	{placement: "telegraf/0", err: `"telegraf/0" is not a valid unit placement`},
+	{placement: "telegraf/0:mysql", err: `"telegraf/0:mysql" is not a valid unit placement`},
+	{placement: "telegraf/0:mysql/1:lxd", err: `"telegraf/0:mysql/1:lxd" is not a valid unit placement`},
+	{placement: "telegraf/0:mysql/1:3:4", err: `"telegraf/0:mysql/1:3:4" is not a valid unit placement`},
+	{placement: "mysql/0:mysql/1", err: `"mysql/0:mysql/1" is not a valid unit placement: .* belong to the same application`},
+}
+
+func (s *unitPlacementSuite) TestParseUnitPlacement(c *gc.C) {
+	for i, test := range parseUnitPlacementTests {
+		c.Logf("test %d: %q", i, test.placement)
+		p, err := names.ParseUnitPlacement(test.placement)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(p.Subordinate, gc.Equals, names.NewUnitTag(test.subordinate))
+		c.Check(p.Principal, gc.Equals, names.NewUnitTag(test.principal))
+		if test.machine != "" {
+			c.Check(p.Machine, gc.Equals, names.NewMachineTag(test.machine))
+		} else {
+			c.Check(p.HasMachine(), jc.IsFalse)
+		}
+		c.Check(p.String(), gc.Equals, test.placement)
+	}
+}
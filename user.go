@@ -118,13 +118,35 @@ func NewLocalUserTag(name string) UserTag {
 
 // ParseUserTag parses a user tag string.
 func ParseUserTag(tag string) (UserTag, error) {
-	t, err := ParseTag(tag)
-	if err != nil {
-		return UserTag{}, err
+	return ParseAs[UserTag](tag)
+}
+
+// TryNewUserTag returns the tag for the given name, or an error if name is
+// not a valid user name.
+func TryNewUserTag(name string) (UserTag, error) {
+	if !IsValidUser(name) {
+		return UserTag{}, fmt.Errorf("invalid user tag %q", name)
 	}
-	ut, ok := t.(UserTag)
-	if !ok {
-		return UserTag{}, invalidTagError(tag, UserTagKind)
+	return NewUserTag(name), nil
+}
+
+// MustNewUserTag returns the tag for the given name.
+// It will panic if name is not a valid user name.
+func MustNewUserTag(name string) UserTag {
+	return mustTag(TryNewUserTag(name))
+}
+
+// TryNewLocalUserTag returns the tag for a local user with the given
+// name, or an error if name is not a valid user name.
+func TryNewLocalUserTag(name string) (UserTag, error) {
+	if !IsValidUserName(name) {
+		return UserTag{}, fmt.Errorf("invalid user name %q", name)
 	}
-	return ut, nil
+	return NewLocalUserTag(name), nil
+}
+
+// MustNewLocalUserTag returns the tag for a local user with the given name.
+// It will panic if name is not a valid user name.
+func MustNewLocalUserTag(name string) UserTag {
+	return mustTag(TryNewLocalUserTag(name))
 }
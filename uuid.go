@@ -0,0 +1,63 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import "regexp"
+
+// uuidSnippet is a non-compiled regexp for matching lowercase RFC 9562
+// UUID strings, checking the version (1 to 8) and the variant.
+const uuidSnippet = `[a-f0-9]{8}-[a-f0-9]{4}-[1-8][a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}`
+
+var (
+	validUUID = regexp.MustCompile("^" + uuidSnippet + "$")
+
+	// lenientValidUUID is the historic UUID check, which is unanchored
+	// and so accepts any string containing something UUID shaped.
+	lenientValidUUID = regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)
+)
+
+func isValidUUID(id string) bool {
+	return validUUID.MatchString(id)
+}
+
+// IsValidUUIDLenient returns whether id contains something UUID shaped.
+// This is how IsValidModel, IsValidController, IsValidApplicationOffer,
+// IsValidCAASModel and IsValidEnvironment validated ids in older versions
+// of this package.
+//
+// Deprecated: it is only for callers that rely on the old behaviour
+// while they migrate.
+func IsValidUUIDLenient(id string) bool {
+	return lenientValidUUID.MatchString(id)
+}
+
+// ParseTagLenient is like ParseTag, but validates the UUIDs of model,
+// controller, application offer, CAAS model and environment tags with
+// IsValidUUIDLenient.
+//
+// Deprecated: it is only for callers that rely on the old behaviour
+// while they migrate.
+func ParseTagLenient(tag string) (Tag, error) {
+	t, err := ParseTag(tag)
+	if err == nil {
+		return t, nil
+	}
+	kind, id, splitErr := splitTag(tag)
+	if splitErr != nil || !IsValidUUIDLenient(id) {
+		return nil, err
+	}
+	switch kind {
+	case ModelTagKind:
+		return NewModelTag(id), nil
+	case ControllerTagKind:
+		return NewControllerTag(id), nil
+	case ApplicationOfferTagKind:
+		return NewApplicationOfferTag(id), nil
+	case CAASModelTagKind:
+		return NewCAASModelTag(id), nil
+	case EnvironTagKind:
+		return NewEnvironTag(id), nil
+	}
+	return nil, err
+}
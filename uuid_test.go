@@ -0,0 +1,119 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type uuidSuite struct{}
+
+var _ = gc.Suite(&uuidSuite{})
+
+var uuidValidationTests = []struct {
+	id      string
+	strict  bool
+	lenient bool
+}{
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", strict: true, lenient: true},
+	{id: "0195847b-95bb-7ca1-a7ee-2211d802d5b3", strict: true, lenient: true},
+	{id: "deadbeef-0123-4567-89ab-feedfacebeef", strict: true, lenient: true},
+	{id: "xf47ac10b-58cc-4372-a567-0e02b2c3d479", strict: false, lenient: true},
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479/foo", strict: false, lenient: true},
+	{id: "f47ac10b-58cc-0372-a567-0e02b2c3d479", strict: false, lenient: true}, // version 0
+	{id: "f47ac10b-58cc-9372-a567-0e02b2c3d479", strict: false, lenient: true}, // version 9
+	{id: "f47ac10b-58cc-4372-c567-0e02b2c3d479", strict: false, lenient: true}, // variant
+	{id: "00000000-0000-0000-0000-000000000000", strict: false, lenient: true},
+	{id: "F47AC10B-58CC-4372-A567-0E02B2C3D479", strict: false, lenient: false},
+	{id: "f47ac10b", strict: false, lenient: false},
+	{id: "", strict: false, lenient: false},
+}
+
+func (s *uuidSuite) TestValidation(c *gc.C) {
+	validators := map[string]func(string) bool{
+		"model":            names.IsValidModel,
+		"controller":       names.IsValidController,
+		"applicationoffer": names.IsValidApplicationOffer,
+		"caasmodel":        names.IsValidCAASModel,
+		"environment":      names.IsValidEnvironment,
+	}
+	for i, test := range uuidValidationTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidUUIDLenient(test.id), gc.Equals, test.lenient)
+		for kind, isValid := range validators {
+			c.Logf("test %d: %s %q", i, kind, test.id)
+			c.Check(isValid(test.id), gc.Equals, test.strict)
+
+			_, err := names.ParseTag(kind + "-" + test.id)
+			c.Check(err == nil, gc.Equals, test.strict)
+
+			tag, err := names.ParseTagLenient(kind + "-" + test.id)
+			if test.lenient {
+				c.Check(err, jc.ErrorIsNil)
+				c.Check(tag.Kind(), gc.Equals, kind)
+				c.Check(tag.Id(), gc.Equals, test.id)
+			} else {
+				c.Check(err, gc.ErrorMatches, `".*" is not a valid `+kind+` tag`)
+			}
+		}
+	}
+}
+
+func (s *uuidSuite) TestParseTagLenientOtherKinds(c *gc.C) {
+	tag, err := names.ParseTagLenient("unit-wordpress-0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tag, gc.Equals, names.NewUnitTag("wordpress/0"))
+
+	tag, err = names.ParseTagLenient("controller-42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tag, gc.Equals, names.NewControllerAgentTag("42"))
+
+	_, err = names.ParseTagLenient("unit-xf47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.ErrorMatches, `"unit-xf47ac10b-58cc-4372-a567-0e02b2c3d479" is not a valid unit tag`)
+}
+
+func (s *uuidSuite) TestParseTagStrict(c *gc.C) {
+	_, err := names.ParseTag("model-xf47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.ErrorMatches, `"model-xf47ac10b-58cc-4372-a567-0e02b2c3d479" is not a valid model tag`)
+}
+
+func (s *uuidSuite) TestCheckedConstructors(c *gc.C) {
+	const uuid = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+	model, err := names.TryNewModelTag(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(model, gc.Equals, names.NewModelTag(uuid))
+	c.Assert(names.MustNewModelTag(uuid), gc.Equals, model)
+	_, err = names.TryNewModelTag("foo")
+	c.Assert(err, gc.ErrorMatches, `"foo" is not a valid model UUID`)
+	c.Assert(func() { names.MustNewModelTag("foo") }, gc.PanicMatches, `"foo" is not a valid model UUID`)
+
+	controller, err := names.TryNewControllerTag(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(controller, gc.Equals, names.NewControllerTag(uuid))
+	c.Assert(func() { names.MustNewControllerTag("foo") }, gc.PanicMatches, `"foo" is not a valid controller UUID`)
+
+	offer, err := names.TryNewApplicationOfferTag(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(offer, gc.Equals, names.NewApplicationOfferTag(uuid))
+	c.Assert(func() { names.MustNewApplicationOfferTag("foo") }, gc.PanicMatches, `"foo" is not a valid application offer UUID`)
+
+	caasModel, err := names.TryNewCAASModelTag(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(caasModel, gc.Equals, names.NewCAASModelTag(uuid))
+	c.Assert(func() { names.MustNewCAASModelTag("foo") }, gc.PanicMatches, `"foo" is not a valid CAAS model UUID`)
+
+	environ, err := names.TryNewEnvironTag(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(environ, gc.Equals, names.NewEnvironTag(uuid))
+	c.Assert(func() { names.MustNewEnvironTag("foo") }, gc.PanicMatches, `"foo" is not a valid environment UUID`)
+}
+
+func (s *uuidSuite) TestShortIdShortInput(c *gc.C) {
+	c.Assert(names.NewModelTag("f47").ShortId(), gc.Equals, "f47")
+	c.Assert(names.ModelTag{}.ShortId(), gc.Equals, "")
+}
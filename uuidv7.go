@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+var validUUIDv7 = regexp.MustCompile("^" + StrictUUIDv7Snippet + "$")
+
+// IsValidUUIDv7 returns whether id is a valid UUID version 7 string.
+// UUIDs of other versions are rejected.
+func IsValidUUIDv7(id string) bool {
+	return validUUIDv7.MatchString(id)
+}
+
+// UUIDv7Time returns the creation time encoded in a UUID version 7
+// string, with millisecond precision.
+func UUIDv7Time(id string) (time.Time, error) {
+	if !IsValidUUIDv7(id) {
+		return time.Time{}, fmt.Errorf("%q is not a valid UUIDv7", id)
+	}
+	// The first 48 bits are the Unix time in milliseconds.
+	b, err := hex.DecodeString(id[0:8] + id[9:13])
+	if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	var ms [8]byte
+	copy(ms[2:], b)
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(ms[:]))).UTC(), nil
+}
+
+// Clock provides the current time. It is satisfied by
+// github.com/juju/clock.Clock.
+type Clock interface {
+	Now() time.Time
+}
+
+type wallClock struct{}
+
+func (wallClock) Now() time.Time { return time.Now() }
+
+// UUIDv7Source generates UUID version 7 strings, and tags for the kinds
+// keyed by them, from an injectable clock and source of randomness.
+type UUIDv7Source struct {
+	clock Clock
+	rand  io.Reader
+}
+
+// NewUUIDv7Source returns a UUIDv7Source reading the time from clock
+// and random bits from rand. A nil clock uses the wall clock and a nil
+// rand uses crypto/rand.
+func NewUUIDv7Source(clock Clock, rand io.Reader) *UUIDv7Source {
+	return &UUIDv7Source{clock: clock, rand: rand}
+}
+
+// NewUUID returns a new UUID version 7 string.
+func (s *UUIDv7Source) NewUUID() (string, error) {
+	clock := s.clock
+	if clock == nil {
+		clock = wallClock{}
+	}
+	r := s.rand
+	if r == nil {
+		r = rand.Reader
+	}
+
+	var u [16]byte
+	if _, err := io.ReadFull(r, u[6:]); err != nil {
+		return "", errors.Annotate(err, "reading random bits for UUIDv7")
+	}
+	ms := clock.Now().UnixMilli()
+	if ms < 0 || ms >= 1<<48 {
+		return "", fmt.Errorf("time %v out of range for UUIDv7", clock.Now())
+	}
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(ms))
+	copy(u[0:6], ts[2:])
+	u[6] = (u[6] & 0x0f) | 0x70 // version 7
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 9562 variant
+
+	h := hex.EncodeToString(u[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32], nil
+}
+
+// NewSpaceTag returns the tag for a new space with a UUIDv7 ID.
+func (s *UUIDv7Source) NewSpaceTag() (SpaceTag, error) {
+	id, err := s.NewUUID()
+	if err != nil {
+		return SpaceTag{}, err
+	}
+	return NewSpaceTag(id), nil
+}
+
+// NewSubnetTag returns the tag for a new subnet with a UUIDv7 ID.
+func (s *UUIDv7Source) NewSubnetTag() (SubnetTag, error) {
+	id, err := s.NewUUID()
+	if err != nil {
+		return SubnetTag{}, err
+	}
+	return NewSubnetTag(id), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/v6"
+)
+
+type uuidv7Suite struct{}
+
+var _ = gc.Suite(&uuidv7Suite{})
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+var uuidv7Tests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "0195847b-95bb-7ca1-a7ee-2211d802d5b3", valid: true},
+	{id: "0195847b-95bb-7ca1-8000-000000000000", valid: true},
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: false}, // version 4
+	{id: "0195847b-95bb-7ca1-c7ee-2211d802d5b3", valid: false}, // wrong variant
+	{id: "0195847B-95BB-7CA1-A7EE-2211D802D5B3", valid: false},
+	{id: "0195847b95bb7ca1a7ee2211d802d5b3", valid: false},
+	{id: "", valid: false},
+}
+
+func (s *uuidv7Suite) TestIsValidUUIDv7(c *gc.C) {
+	for i, test := range uuidv7Tests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidUUIDv7(test.id), gc.Equals, test.valid)
+	}
+}
+
+func (s *uuidv7Suite) TestUUIDv7Time(c *gc.C) {
+	created, err := names.UUIDv7Time("0195847b-95bb-7ca1-a7ee-2211d802d5b3")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created, gc.Equals, time.UnixMilli(0x0195847b95bb).UTC())
+
+	_, err = names.UUIDv7Time("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Assert(err, gc.ErrorMatches, `"f47ac10b-58cc-4372-a567-0e02b2c3d479" is not a valid UUIDv7`)
+}
+
+func (s *uuidv7Suite) TestNewUUID(c *gc.C) {
+	now := time.Date(2025, 3, 14, 15, 9, 26, 535000000, time.UTC)
+	source := names.NewUUIDv7Source(fixedClock(now), bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	id, err := source.NewUUID()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(id, gc.Equals, "01959533-fa87-7fff-bfff-ffffffffffff")
+	c.Assert(names.IsValidUUIDv7(id), jc.IsTrue)
+
+	created, err := names.UUIDv7Time(id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created, gc.Equals, now)
+}
+
+func (s *uuidv7Suite) TestNewUUIDDefaults(c *gc.C) {
+	before := time.Now().Truncate(time.Millisecond)
+	id, err := names.NewUUIDv7Source(nil, nil).NewUUID()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names.IsValidUUIDv7(id), jc.IsTrue)
+	created, err := names.UUIDv7Time(id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created.Before(before), jc.IsFalse)
+}
+
+func (s *uuidv7Suite) TestNewUUIDShortRead(c *gc.C) {
+	source := names.NewUUIDv7Source(nil, strings.NewReader("short"))
+	_, err := source.NewUUID()
+	c.Assert(err, gc.ErrorMatches, "reading random bits for UUIDv7: unexpected EOF")
+}
+
+func (s *uuidv7Suite) TestNewTags(c *gc.C) {
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	source := names.NewUUIDv7Source(fixedClock(now), bytes.NewReader(make([]byte, 20)))
+
+	space, err := source.NewSpaceTag()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(space.IsLegacy(), jc.IsFalse)
+	created, ok := space.CreatedAt()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(created, gc.Equals, now)
+
+	subnet, err := source.NewSubnetTag()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subnet.IsLegacy(), jc.IsFalse)
+	created, ok = subnet.CreatedAt()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(created, gc.Equals, now)
+
+	_, ok = names.NewSpaceTag("alpha").CreatedAt()
+	c.Assert(ok, jc.IsFalse)
+	_, ok = names.NewSubnetTag("3").CreatedAt()
+	c.Assert(ok, jc.IsFalse)
+}
@@ -35,15 +35,7 @@ func NewVolumeTag(id string) VolumeTag {
 
 // ParseVolumeTag parses a volume tag string.
 func ParseVolumeTag(volumeTag string) (VolumeTag, error) {
-	tag, err := ParseTag(volumeTag)
-	if err != nil {
-		return VolumeTag{}, err
-	}
-	dt, ok := tag.(VolumeTag)
-	if !ok {
-		return VolumeTag{}, invalidTagError(volumeTag, VolumeTagKind)
-	}
-	return dt, nil
+	return ParseAs[VolumeTag](volumeTag)
 }
 
 // IsValidVolume returns whether id is a valid volume ID.
@@ -90,3 +82,18 @@ func tagFromVolumeId(id string) (VolumeTag, bool) {
 	id = strings.Replace(id, "/", "-", -1)
 	return VolumeTag{id}, true
 }
+
+// TryNewVolumeTag returns the tag for the given id, or an error if id is
+// not a valid volume ID.
+func TryNewVolumeTag(id string) (VolumeTag, error) {
+	if !IsValidVolume(id) {
+		return VolumeTag{}, fmt.Errorf("%q is not a valid volume ID", id)
+	}
+	return NewVolumeTag(id), nil
+}
+
+// MustNewVolumeTag returns the tag for the given id.
+// It will panic if id is not a valid volume ID.
+func MustNewVolumeTag(id string) VolumeTag {
+	return mustTag(TryNewVolumeTag(id))
+}